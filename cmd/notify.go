@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/notify"
+	"github.com/spf13/cobra"
+)
+
+var notifyChannelFlag string
+
+var notifyCmd = &cobra.Command{
+	Use:   "notify",
+	Short: "Desktop notification tools",
+}
+
+var notifyTestCmd = &cobra.Command{
+	Use:   "test <kind>",
+	Short: "Send a sample notification to check they show up",
+	Long: `Send a sample desktop notification of the given kind, with a terminal beep.
+
+Kinds: ` + strings.Join(kindNames(), ", ") + `
+
+If the beep sounds but no notification shows, see "Notification Setup" in the README.`,
+	Args:          cobra.ExactArgs(1),
+	ValidArgs:     kindNames(),
+	SilenceUsage:  true, // The error lists the kinds
+	SilenceErrors: true, // Execute prints it
+	RunE: func(cmd *cobra.Command, args []string) error {
+		kind := notify.Kind(args[0])
+		if !slices.Contains(notify.Kinds, kind) {
+			return fmt.Errorf("unknown notification kind %q (want one of: %s)", args[0], strings.Join(kindNames(), ", "))
+		}
+
+		if notifyChannelFlag == notify.ChannelDesktop {
+			if err := notify.NewDesktopNotifier().NotifyEvent(sampleEvent(kind)); err != nil {
+				return err
+			}
+			fmt.Printf("Sent a %s test notification.\n", strings.ToLower(kind.Label()))
+			return nil
+		}
+
+		// A webhook or command hook from settings.yaml; failures are printed
+		settings, err := data.LoadSettings()
+		if err != nil {
+			return fmt.Errorf("load settings: %w", err)
+		}
+		for _, target := range settings.Webhooks {
+			if target.Name != notifyChannelFlag {
+				continue
+			}
+			failed := false
+			webhook, err := notify.NewWebhookNotifier(target, func(message string) {
+				failed = true
+				fmt.Println(message)
+			})
+			if err != nil {
+				return err
+			}
+			err = webhook.NotifyEvent(sampleEvent(kind))
+			webhook.Close() // Waits for the post
+			if err != nil || failed {
+				return fmt.Errorf("posting to webhook %q failed", target.Name)
+			}
+			fmt.Printf("Posted a %s test notification to %s.\n", strings.ToLower(kind.Label()), target.Name)
+			return nil
+		}
+		for _, hook := range settings.Hooks {
+			if hook.Name != notifyChannelFlag {
+				continue
+			}
+			failed := false
+			command, err := notify.NewCommandNotifier(hook, func(message string) {
+				failed = true
+				fmt.Println(message)
+			})
+			if err != nil {
+				return err
+			}
+			err = command.NotifyEvent(sampleEvent(kind))
+			command.Close() // Waits for the command
+			if err != nil || failed {
+				return fmt.Errorf("running hook %q failed", hook.Name)
+			}
+			fmt.Printf("Ran hook %s for a %s test notification.\n", hook.Name, strings.ToLower(kind.Label()))
+			return nil
+		}
+		return fmt.Errorf("no webhook or hook named %q in settings.yaml", notifyChannelFlag)
+	},
+}
+
+// kindNames returns the notification kinds as named on the command line.
+func kindNames() []string {
+	names := make([]string, len(notify.Kinds))
+	for i, kind := range notify.Kinds {
+		names[i] = string(kind)
+	}
+	return names
+}
+
+// sampleEvent returns a made-up event of the kind, in a Liverpool v Man City match.
+func sampleEvent(kind notify.Kind) notify.Event {
+	name := func(s string) *string { return &s }
+	score := func(n int) *int { return &n }
+	kickoff := time.Now().Add(time.Hour)
+
+	match := api.Match{
+		League:    api.League{ID: 47, Name: "Premier League"},
+		HomeTeam:  api.Team{ID: 8650, Name: "Liverpool", ShortName: "LIV"},
+		AwayTeam:  api.Team{ID: 8456, Name: "Manchester City", ShortName: "MCI"},
+		State:     api.MatchState{Phase: api.PhaseSecondHalf, Minute: 67},
+		HomeScore: score(2),
+		AwayScore: score(1),
+		MatchTime: &kickoff,
+	}
+	event := notify.Event{Kind: kind, Match: match, HomeScore: 2, AwayScore: 1}
+
+	switch kind {
+	case notify.KindGoal, notify.KindVAR:
+		event.Event = api.MatchEvent{Minute: 34, DisplayMinute: "34'", Kind: api.EventGoal, Team: match.HomeTeam, Player: name("Salah"), Assist: name("Szoboszlai")}
+	case notify.KindRedCard:
+		event.Event = api.MatchEvent{Minute: 67, DisplayMinute: "67'", Kind: api.EventSecondYellow, Team: match.AwayTeam, Player: name("Rodri")}
+	case notify.KindPenaltyAwarded:
+		event.Event = api.MatchEvent{Minute: 71, DisplayMinute: "71'", Kind: api.EventVAR, Team: match.HomeTeam, Detail: "Penalty confirmed"}
+	case notify.KindPenaltyMissed:
+		event.Event = api.MatchEvent{Minute: 73, DisplayMinute: "73'", Kind: api.EventMissedPenalty, Team: match.HomeTeam, Player: name("Salah")}
+	case notify.KindKickoff, notify.KindLineups, notify.KindPostponed:
+		event.Match.State = api.MatchState{Phase: api.PhasePreMatch}
+		event.HomeScore, event.AwayScore = 0, 0
+	case notify.KindHalfTime:
+		event.Match.State = api.MatchState{Phase: api.PhaseHalfTime, Minute: 45}
+		event.HomeScore = 1
+	case notify.KindFullTime:
+		event.Match.State = api.MatchState{Phase: api.PhaseFullTime, Minute: 90}
+	}
+	return event
+}
+
+func init() {
+	notifyTestCmd.Flags().StringVarP(&notifyChannelFlag, "channel", "c", notify.ChannelDesktop, "Channel to notify: desktop or a webhook or hook name from settings.yaml")
+	notifyCmd.AddCommand(notifyTestCmd)
+	rootCmd.AddCommand(notifyCmd)
+}
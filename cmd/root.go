@@ -64,7 +64,9 @@ var rootCmd = &cobra.Command{
 		}()
 
 		p := tea.NewProgram(app.New(mockFlag, debugFlag, isDevBuild, newVersionAvailable), tea.WithAltScreen())
-		if _, err := p.Run(); err != nil {
+		final, err := p.Run()
+		app.Close(final)
+		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error running application: %v\n", err)
 			os.Exit(1)
 		}
@@ -22,4 +22,19 @@ type Client interface {
 
 	// LeagueTable retrieves the league table/standings for a specific league.
 	LeagueTable(ctx context.Context, leagueID int) ([]LeagueTableEntry, error)
+
+	// LeagueTables retrieves a competition's standings as one or more named tables (e.g., tournament groups).
+	LeagueTables(ctx context.Context, leagueID int) ([]TableGroup, error)
+
+	// LeagueLeaders retrieves season stat leaderboards (goals, assists, rating, ...) for a league.
+	LeagueLeaders(ctx context.Context, leagueID int) ([]LeaderboardCategory, error)
+
+	// LeagueBracket retrieves the knockout rounds of a cup competition, earliest round first.
+	LeagueBracket(ctx context.Context, leagueID int) ([]BracketRound, error)
+
+	// TeamDetails retrieves a team's fixtures, results, table position, top scorers and squad.
+	TeamDetails(ctx context.Context, teamID int) (*TeamDetails, error)
+
+	// Player retrieves a player's profile, season statistics, recent ratings and career.
+	Player(ctx context.Context, playerID int) (*Player, error)
 }
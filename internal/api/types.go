@@ -1,6 +1,9 @@
 package api
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // League represents a football league
 type League struct {
@@ -19,6 +22,13 @@ type Team struct {
 	Logo      string `json:"logo,omitempty"`
 }
 
+// TeamSearchResult is a team found by name.
+type TeamSearchResult struct {
+	Team     Team
+	LeagueID int    // The team's primary league
+	League   string // The league's name, to tell same-named teams apart
+}
+
 // MatchStatus represents the status of a match
 type MatchStatus string
 
@@ -30,33 +40,320 @@ const (
 	MatchStatusCancelled  MatchStatus = "cancelled"
 )
 
+// MatchPhase is the stage a match is in, finer-grained than MatchStatus
+type MatchPhase string
+
+const (
+	PhasePreMatch        MatchPhase = "pre"
+	PhaseFirstHalf       MatchPhase = "first_half"
+	PhaseHalfTime        MatchPhase = "half_time"
+	PhaseSecondHalf      MatchPhase = "second_half"
+	PhaseExtraTimeBreak  MatchPhase = "extra_time_break" // Before extra time or between its halves
+	PhaseExtraTimeFirst  MatchPhase = "extra_time_first_half"
+	PhaseExtraTimeSecond MatchPhase = "extra_time_second_half"
+	PhasePenalties       MatchPhase = "penalties" // Shootout in progress
+	PhaseFullTime        MatchPhase = "full_time"
+	PhaseAfterExtraTime  MatchPhase = "after_extra_time"
+	PhaseAfterPenalties  MatchPhase = "after_penalties"
+	PhasePostponed       MatchPhase = "postponed"
+	PhaseAbandoned       MatchPhase = "abandoned"
+	PhaseInterrupted     MatchPhase = "interrupted" // Play stopped for now (weather, crowd trouble), expected to restart
+	PhaseSuspended       MatchPhase = "suspended"   // Suspended by the referee, to be resumed
+	PhaseCancelled       MatchPhase = "cancelled"
+)
+
+// MatchState is the structured status of a match: its phase and, while in play, the clock
+type MatchState struct {
+	Phase     MatchPhase `json:"phase"`
+	Minute    int        `json:"minute,omitempty"`     // Elapsed minute, e.g., 45 for "45+2'"
+	AddedTime int        `json:"added_time,omitempty"` // Stoppage-time minutes beyond Minute, e.g., 2 for "45+2'"
+}
+
+// Status returns the coarse match status for the phase.
+// Suspended matches count as live (they are expected to resume); abandoned ones as cancelled.
+func (s MatchState) Status() MatchStatus {
+	switch s.Phase {
+	case PhaseFirstHalf, PhaseHalfTime, PhaseSecondHalf, PhaseExtraTimeBreak, PhaseExtraTimeFirst, PhaseExtraTimeSecond,
+		PhasePenalties, PhaseInterrupted, PhaseSuspended:
+		return MatchStatusLive
+	case PhaseFullTime, PhaseAfterExtraTime, PhaseAfterPenalties:
+		return MatchStatusFinished
+	case PhasePostponed:
+		return MatchStatusPostponed
+	case PhaseAbandoned, PhaseCancelled:
+		return MatchStatusCancelled
+	default:
+		return MatchStatusNotStarted
+	}
+}
+
+// Period returns the minutes played before a running period and its regulation length
+// (e.g., 45 and 45 for the second half). ok is false outside the four periods of play.
+func (p MatchPhase) Period() (base, length int, ok bool) {
+	switch p {
+	case PhaseFirstHalf:
+		return 0, 45, true
+	case PhaseSecondHalf:
+		return 45, 45, true
+	case PhaseExtraTimeFirst:
+		return 90, 15, true
+	case PhaseExtraTimeSecond:
+		return 105, 15, true
+	default:
+		return 0, 0, false
+	}
+}
+
+// InPlay reports whether the match has started and not yet ended (including breaks).
+func (s MatchState) InPlay() bool {
+	return s.Status() == MatchStatusLive
+}
+
+// Label returns a short display label: the clock while running (e.g., "45+2'"),
+// otherwise the phase (e.g., "HT", "FT", "AET", "Postponed"). Empty before kickoff or when the clock is unknown.
+func (s MatchState) Label() string {
+	switch s.Phase {
+	case PhaseFirstHalf, PhaseSecondHalf, PhaseExtraTimeFirst, PhaseExtraTimeSecond:
+		switch {
+		case s.Minute == 0:
+			return "" // Clock unknown
+		case s.AddedTime > 0:
+			return fmt.Sprintf("%d+%d'", s.Minute, s.AddedTime)
+		default:
+			return fmt.Sprintf("%d'", s.Minute)
+		}
+	case PhaseHalfTime:
+		return "HT"
+	case PhaseExtraTimeBreak:
+		return "ET break"
+	case PhasePenalties:
+		return "Pens"
+	case PhaseInterrupted:
+		return "Interrupted"
+	case PhaseSuspended:
+		return "Suspended"
+	case PhaseFullTime:
+		return "FT"
+	case PhaseAfterExtraTime:
+		return "AET"
+	case PhaseAfterPenalties:
+		return "AP"
+	case PhasePostponed:
+		return "Postponed"
+	case PhaseAbandoned:
+		return "Abandoned"
+	case PhaseCancelled:
+		return "Cancelled"
+	default:
+		return ""
+	}
+}
+
 // Match represents a football match
 type Match struct {
 	ID        int         `json:"id"`
 	League    League      `json:"league"`
 	HomeTeam  Team        `json:"home_team"`
 	AwayTeam  Team        `json:"away_team"`
-	Status    MatchStatus `json:"status"`
+	Status    MatchStatus `json:"status"` // Always State.Status() for parsed matches
+	State     MatchState  `json:"state"`
 	HomeScore *int        `json:"home_score,omitempty"`
 	AwayScore *int        `json:"away_score,omitempty"`
 	MatchTime *time.Time  `json:"match_time,omitempty"`
-	LiveTime  *string     `json:"live_time,omitempty"` // e.g., "45+2", "HT", "FT"
 	Round     string      `json:"round,omitempty"`
+
+	// When the current period kicked off, to run the clock between polls (nil when unknown)
+	PeriodStart *time.Time `json:"period_start,omitempty"`
+
+	// Aggregate score over both legs of a two-legged tie (second leg only), from this match's home/away perspective
+	HomeAggregate *int `json:"home_aggregate,omitempty"`
+	AwayAggregate *int `json:"away_aggregate,omitempty"`
+}
+
+// maxRunningAddedTime is how far into stoppage time the clock runs before waiting for a poll.
+const maxRunningAddedTime = 20
+
+// StateAt returns the match state with the clock run forward to now from the period start,
+// so the minute keeps ticking between polls ("67'", then "68'", then "90+1'" in stoppage time).
+// Breaks and matches without a period start keep the polled state, and the clock never
+// runs behind the last poll; the next poll resyncs it.
+func (m Match) StateAt(now time.Time) MatchState {
+	base, length, ok := m.State.Phase.Period()
+	if !ok || m.PeriodStart == nil {
+		return m.State
+	}
+
+	elapsed := now.Sub(*m.PeriodStart)
+	if elapsed < 0 || elapsed >= time.Duration(length+maxRunningAddedTime)*time.Minute {
+		return m.State // Out of sync with the poll
+	}
+
+	// Football minutes count up from 1: the first 60 seconds of a period are its first minute
+	state := MatchState{Phase: m.State.Phase, Minute: base + int(elapsed/time.Minute) + 1}
+	if end := base + length; state.Minute > end {
+		state.Minute, state.AddedTime = end, state.Minute-end
+	}
+	if state.Minute+state.AddedTime < m.State.Minute+m.State.AddedTime {
+		return m.State
+	}
+	return state
+}
+
+// EventKind is the type of a match event
+type EventKind string
+
+const (
+	EventGoal          EventKind = "goal"
+	EventOwnGoal       EventKind = "own_goal"
+	EventPenaltyGoal   EventKind = "penalty_goal" // Goal from a penalty kick (not a shootout)
+	EventMissedPenalty EventKind = "missed_penalty"
+	EventYellowCard    EventKind = "yellow_card"
+	EventSecondYellow  EventKind = "second_yellow" // Second yellow, sending the player off
+	EventRedCard       EventKind = "red_card"
+	EventSubstitution  EventKind = "substitution"
+	EventVAR           EventKind = "var"
+	EventAddedTime     EventKind = "added_time"
+	EventOther         EventKind = "other"
+)
+
+// Label returns a short uppercase label for the kind (e.g., "OWN GOAL"), or "" for other events.
+func (k EventKind) Label() string {
+	switch k {
+	case EventGoal:
+		return "GOAL"
+	case EventOwnGoal:
+		return "OWN GOAL"
+	case EventPenaltyGoal:
+		return "PEN GOAL"
+	case EventMissedPenalty:
+		return "PEN MISSED"
+	case EventYellowCard, EventRedCard:
+		return "CARD"
+	case EventSecondYellow:
+		return "2ND YELLOW"
+	case EventSubstitution:
+		return "SUB"
+	case EventVAR:
+		return "VAR"
+	default:
+		return ""
+	}
+}
+
+// IsGoal reports whether the event changes the score.
+func (k EventKind) IsGoal() bool {
+	return k == EventGoal || k == EventOwnGoal || k == EventPenaltyGoal
+}
+
+// IsCard reports whether the event is a booking.
+func (k EventKind) IsCard() bool {
+	return k == EventYellowCard || k == EventSecondYellow || k == EventRedCard
+}
+
+// IsSendingOff reports whether the event sends a player off.
+func (k EventKind) IsSendingOff() bool {
+	return k == EventSecondYellow || k == EventRedCard
 }
 
 // MatchEvent represents an event in a match (goal, card, substitution, etc.)
 type MatchEvent struct {
 	ID            int       `json:"id"`
-	Minute        int       `json:"minute"`        // Base minute (e.g., 45)
+	Minute        int       `json:"minute"`                   // Base minute (e.g., 45)
 	DisplayMinute string    `json:"display_minute,omitempty"` // Formatted minute with stoppage time (e.g., "45+2'")
-	Type          string    `json:"type"`          // "goal", "card", "substitution", etc.
-	Team          Team      `json:"team"`
-	Player        *string   `json:"player,omitempty"`
-	Assist        *string   `json:"assist,omitempty"`
-	EventType     *string   `json:"event_type,omitempty"` // "yellow", "red", "in", "out", etc.
+	Kind          EventKind `json:"kind"`
+	Team          Team      `json:"team"`                // Team the event counts for; for own goals, the team credited with the goal
+	Player        *string   `json:"player,omitempty"`    // Scorer, booked player, or player going off; for own goals, the opponent who scored
+	PlayerID      int       `json:"player_id,omitempty"` // FotMob player ID of Player (0 if unknown)
+	Assist        *string   `json:"assist,omitempty"`    // Goal assist
+	PlayerIn      *string   `json:"player_in,omitempty"` // Substitutions: player coming on
+	PlayerInID    int       `json:"player_in_id,omitempty"`
+	Detail        string    `json:"detail,omitempty"` // VAR decision (e.g., "Goal disallowed"), added minutes, or other event text
 	Timestamp     time.Time `json:"timestamp"`
 }
 
+// TimelineEntry is one event on a match timeline, resolved from a MatchEvent for display.
+// Names are plain text, so the UI never has to parse them back out of a formatted string.
+type TimelineEntry struct {
+	EventID   int       `json:"event_id"`
+	Kind      EventKind `json:"kind"`
+	Minute    int       `json:"minute"`               // Base minute (e.g., 45)
+	AddedTime int       `json:"added_time,omitempty"` // Stoppage-time minutes (e.g., 2 for 45+2')
+	Home      bool      `json:"home"`                 // Event belongs to the home side of the timeline
+	Player    string    `json:"player,omitempty"`     // Scorer, booked player, or player going off
+	PlayerIn  string    `json:"player_in,omitempty"`  // Substitutions: player coming on
+	Assist    string    `json:"assist,omitempty"`
+	Detail    string    `json:"detail,omitempty"` // VAR decision or other event text
+
+	Disallowed bool `json:"disallowed,omitempty"` // Goal later removed by the provider (VAR, score correction)
+}
+
+// MinuteLabel returns the timeline minute, e.g. "67'" or "45+2'".
+func (e TimelineEntry) MinuteLabel() string {
+	return minuteLabel(e.Minute, e.AddedTime)
+}
+
+// After reports whether e happened later in the match than other.
+// Stoppage time counts before the next period, so 45+3' is after 45+1' but before 46'.
+func (e TimelineEntry) After(other TimelineEntry) bool {
+	if e.Minute != other.Minute {
+		return e.Minute > other.Minute
+	}
+	return e.AddedTime > other.AddedTime
+}
+
+// CommentaryEntry is one line of live text commentary, newest first in a feed.
+type CommentaryEntry struct {
+	ID        string    `json:"id,omitempty"` // Provider ID; Key falls back to minute and text when empty
+	Minute    int       `json:"minute"`       // 0 for pre-match and post-match lines
+	AddedTime int       `json:"added_time,omitempty"`
+	Kind      EventKind `json:"kind"` // Event the line describes; EventOther for general play
+	Text      string    `json:"text"`
+	Important bool      `json:"important,omitempty"` // Goals, cards and other key moments
+}
+
+// MinuteLabel returns the commentary minute, e.g. "67'" or "45+2'" ("" for lines without a minute).
+func (c CommentaryEntry) MinuteLabel() string {
+	if c.Minute == 0 && c.AddedTime == 0 {
+		return ""
+	}
+	return minuteLabel(c.Minute, c.AddedTime)
+}
+
+// Key identifies the entry across polls.
+func (c CommentaryEntry) Key() string {
+	if c.ID != "" {
+		return c.ID
+	}
+	return c.MinuteLabel() + " " + c.Text
+}
+
+// minuteLabel formats a match minute with optional stoppage time.
+func minuteLabel(minute, addedTime int) string {
+	if addedTime > 0 {
+		return fmt.Sprintf("%d+%d'", minute, addedTime)
+	}
+	return fmt.Sprintf("%d'", minute)
+}
+
+// PenaltyOutcome is the result of a single shootout kick.
+type PenaltyOutcome string
+
+const (
+	PenaltyScored PenaltyOutcome = "scored"
+	PenaltySaved  PenaltyOutcome = "saved"
+	PenaltyMissed PenaltyOutcome = "missed" // Off target or hit the woodwork
+)
+
+// PenaltyKick represents one kick of a penalty shootout
+type PenaltyKick struct {
+	Team      Team           `json:"team"`
+	Player    string         `json:"player,omitempty"`
+	PlayerID  int            `json:"player_id,omitempty"`
+	Outcome   PenaltyOutcome `json:"outcome"`
+	HomeScore int            `json:"home_score"` // Shootout score after this kick
+	AwayScore int            `json:"away_score"`
+}
+
 // MatchStatistic represents a single match statistic (possession, shots, etc.)
 type MatchStatistic struct {
 	Key       string `json:"key"`        // e.g., "possession", "shots_total"
@@ -74,6 +371,33 @@ type PlayerInfo struct {
 	Rating   string `json:"rating,omitempty"` // Player rating (e.g., "7.2")
 }
 
+// UnavailablePlayer represents a player ruled out of a match (injury, suspension, etc.)
+type UnavailablePlayer struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Number         int    `json:"number,omitempty"`
+	Reason         string `json:"reason,omitempty"`          // e.g., "injury", "suspension"
+	ExpectedReturn string `json:"expected_return,omitempty"` // e.g., "Late January 2026"
+}
+
+// FormResult represents one of a team's recent results (used for last-five form).
+type FormResult struct {
+	Result    string     `json:"result"`               // "W", "D" or "L"
+	Score     string     `json:"score,omitempty"`      // e.g., "2-1"
+	Opponent  string     `json:"opponent,omitempty"`   // Opponent team name
+	IsHome    bool       `json:"is_home,omitempty"`    // Whether the team played at home
+	MatchTime *time.Time `json:"match_time,omitempty"` // Kickoff time of the result
+}
+
+// HeadToHead summarises previous meetings between two teams.
+// Wins are counted from the perspective of the current match's home and away teams.
+type HeadToHead struct {
+	HomeWins int     `json:"home_wins"`
+	Draws    int     `json:"draws"`
+	AwayWins int     `json:"away_wins"`
+	Matches  []Match `json:"matches,omitempty"` // Most recent meetings first
+}
+
 // MatchDetails contains detailed information about a match
 type MatchDetails struct {
 	Match
@@ -94,6 +418,7 @@ type MatchDetails struct {
 		Home *int `json:"home,omitempty"`
 		Away *int `json:"away,omitempty"`
 	} `json:"penalties,omitempty"`
+	Shootout []PenaltyKick `json:"shootout,omitempty"` // Penalty shootout kicks in the order taken
 
 	// Extended statistics
 	Statistics []MatchStatistic `json:"statistics,omitempty"` // Match statistics (possession, shots, etc.)
@@ -114,6 +439,16 @@ type MatchDetails struct {
 	HomeSubstitutes []PlayerInfo `json:"home_substitutes,omitempty"`
 	AwaySubstitutes []PlayerInfo `json:"away_substitutes,omitempty"`
 
+	// Unavailable players (injured, suspended, etc.)
+	HomeUnavailable []UnavailablePlayer `json:"home_unavailable,omitempty"`
+	AwayUnavailable []UnavailablePlayer `json:"away_unavailable,omitempty"`
+	LineupPredicted bool                `json:"lineup_predicted,omitempty"` // Lineups are predicted, not confirmed
+
+	// Pre-match context
+	HeadToHead *HeadToHead  `json:"head_to_head,omitempty"`
+	HomeForm   []FormResult `json:"home_form,omitempty"` // Most recent first
+	AwayForm   []FormResult `json:"away_form,omitempty"` // Most recent first
+
 	// Momentum/xG data (if available)
 	HomeXG *float64 `json:"home_xg,omitempty"` // Expected goals for home team
 	AwayXG *float64 `json:"away_xg,omitempty"` // Expected goals for away team
@@ -132,3 +467,124 @@ type LeagueTableEntry struct {
 	GoalDifference int  `json:"goal_difference"`
 	Points         int  `json:"points"`
 }
+
+// TableGroup is one standings table of a competition. Leagues have a single group;
+// tournaments have one per group (e.g., "Group A").
+type TableGroup struct {
+	Name    string             `json:"name"`
+	Entries []LeagueTableEntry `json:"entries"`
+}
+
+// LeaderboardCategory is one season stat leaderboard for a league (e.g., top scorers).
+type LeaderboardCategory struct {
+	Key     string             `json:"key"`   // e.g., "goals", "goal_assist", "rating"
+	Title   string             `json:"title"` // e.g., "Top scorer"
+	Entries []LeaderboardEntry `json:"entries"`
+}
+
+// LeaderboardEntry is a player's place on a league leaderboard.
+type LeaderboardEntry struct {
+	Rank       int     `json:"rank"`
+	PlayerID   int     `json:"player_id"`
+	PlayerName string  `json:"player_name"`
+	Team       Team    `json:"team"`
+	Value      float64 `json:"value"` // Goals, assists, average rating, etc.
+}
+
+// BracketRound is one knockout round of a cup competition (e.g., quarter-finals).
+type BracketRound struct {
+	Stage string       `json:"stage"` // Display name, e.g., "Quarter-finals"
+	Ties  []BracketTie `json:"ties"`
+}
+
+// BracketTie is a knockout pairing, played over one or two legs.
+// Teams may be empty (zero ID) while a previous round is still undecided.
+type BracketTie struct {
+	HomeTeam      Team    `json:"home_team"`
+	AwayTeam      Team    `json:"away_team"`
+	HomeAggregate *int    `json:"home_aggregate,omitempty"` // Total over all legs, nil until a leg has started
+	AwayAggregate *int    `json:"away_aggregate,omitempty"`
+	HomePenalties *int    `json:"home_penalties,omitempty"` // Shootout score, if the tie went to penalties
+	AwayPenalties *int    `json:"away_penalties,omitempty"`
+	WinnerID      int     `json:"winner_id,omitempty"` // 0 until decided
+	Legs          []Match `json:"legs"`
+}
+
+// TeamDetails contains a team's overview across all competitions.
+type TeamDetails struct {
+	Team
+	Country          string            `json:"country,omitempty"`
+	TableLeague      League            `json:"table_league,omitempty"`   // League the table position refers to
+	TablePosition    *LeagueTableEntry `json:"table_position,omitempty"` // Current position in TableLeague, if available
+	RecentResults    []Match           `json:"recent_results,omitempty"` // Most recent first
+	UpcomingFixtures []Match           `json:"upcoming_fixtures,omitempty"`
+	TopScorers       []TeamTopScorer   `json:"top_scorers,omitempty"`
+	Squad            []SquadPlayer     `json:"squad,omitempty"`
+}
+
+// TeamTopScorer represents one of a team's leading goalscorers this season.
+type TeamTopScorer struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Goals int    `json:"goals"`
+}
+
+// SquadPlayer represents a member of a team's squad.
+type SquadPlayer struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Number   int    `json:"number,omitempty"`
+	Position string `json:"position"` // "Goalkeeper", "Defender", "Midfielder", "Attacker" or "Coach"
+	Country  string `json:"country,omitempty"`
+	Age      int    `json:"age,omitempty"`
+}
+
+// Player contains a player's profile: bio, season statistics, recent form and career.
+type Player struct {
+	ID            int                 `json:"id"`
+	Name          string              `json:"name"`
+	Position      string              `json:"position,omitempty"` // e.g., "Striker"
+	Team          Team                `json:"team"`
+	Nationality   string              `json:"nationality,omitempty"`
+	Age           int                 `json:"age,omitempty"`
+	Number        int                 `json:"number,omitempty"`
+	Height        string              `json:"height,omitempty"`         // e.g., "183 cm"
+	SeasonStats   []PlayerSeasonStats `json:"season_stats,omitempty"`   // Current season, one entry per competition
+	RecentMatches []PlayerMatchRating `json:"recent_matches,omitempty"` // Most recent first
+	Career        []PlayerCareerEntry `json:"career,omitempty"`         // Most recent first
+}
+
+// PlayerSeasonStats contains a player's statistics for one competition in a season.
+type PlayerSeasonStats struct {
+	LeagueID    int     `json:"league_id,omitempty"`
+	LeagueName  string  `json:"league_name"`
+	Season      string  `json:"season,omitempty"` // e.g., "2025/2026"
+	Appearances int     `json:"appearances"`
+	Goals       int     `json:"goals"`
+	Assists     int     `json:"assists"`
+	Minutes     int     `json:"minutes,omitempty"`
+	Rating      float64 `json:"rating,omitempty"` // Average FotMob rating (0 if unavailable)
+}
+
+// PlayerMatchRating is a player's rating and contribution in a single recent match.
+type PlayerMatchRating struct {
+	MatchID    int        `json:"match_id"`
+	Opponent   string     `json:"opponent"`
+	LeagueName string     `json:"league_name,omitempty"`
+	MatchTime  *time.Time `json:"match_time,omitempty"`
+	Rating     float64    `json:"rating,omitempty"` // 0 if the player didn't get a rating
+	Minutes    int        `json:"minutes,omitempty"`
+	Goals      int        `json:"goals,omitempty"`
+	Assists    int        `json:"assists,omitempty"`
+}
+
+// PlayerCareerEntry is one club (or national team) spell in a player's career.
+type PlayerCareerEntry struct {
+	TeamID      int    `json:"team_id"`
+	TeamName    string `json:"team_name"`
+	StartYear   int    `json:"start_year,omitempty"`
+	EndYear     int    `json:"end_year,omitempty"` // 0 for the current club
+	Appearances int    `json:"appearances,omitempty"`
+	Goals       int    `json:"goals,omitempty"`
+	Active      bool   `json:"active,omitempty"`
+}
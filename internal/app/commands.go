@@ -9,12 +9,10 @@ import (
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
 	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/0xjuanma/golazo/internal/watcher"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
-// LiveRefreshInterval is the interval between automatic live matches list refreshes.
-const LiveRefreshInterval = 5 * time.Minute
-
 // fetchLiveMatches fetches live matches from the API (used for cache check only now).
 // Returns mock data if useMockData is true, otherwise uses real API.
 // NOTE: For initial load, use fetchLiveLeagueData for progressive loading.
@@ -83,7 +81,7 @@ func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int)
 		// Fetch all leagues in this batch concurrently
 		var wg sync.WaitGroup
 		var mu sync.Mutex
-		var allMatches []api.Match
+		var allMatches, allUpcoming []api.Match
 
 		for i := startIdx; i < endIdx; i++ {
 			wg.Add(1)
@@ -94,13 +92,14 @@ func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int)
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 
-				matches, err := client.LiveMatchesForLeague(ctx, leagueID)
-				if err != nil || len(matches) == 0 {
+				matches, upcoming, err := client.TodayMatchesForLeague(ctx, leagueID)
+				if err != nil || (len(matches) == 0 && len(upcoming) == 0) {
 					return
 				}
 
 				mu.Lock()
 				allMatches = append(allMatches, matches...)
+				allUpcoming = append(allUpcoming, upcoming...)
 				mu.Unlock()
 			}(i)
 		}
@@ -111,20 +110,22 @@ func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int)
 			batchIndex: batchIndex,
 			isLast:     isLast,
 			matches:    allMatches,
+			upcoming:   allUpcoming,
 		}
 	}
 }
 
-// scheduleLiveRefresh schedules the next live matches refresh after 5 minutes.
+// scheduleLiveRefresh schedules the next live matches refresh after delay (see watcher.NextRefresh).
 // This is used to keep the live matches list current while the user is in the view.
-func scheduleLiveRefresh(client *fotmob.Client, useMockData bool) tea.Cmd {
-	return tea.Tick(LiveRefreshInterval, func(t time.Time) tea.Msg {
+// gen identifies the refresh chain, so a chain replaced by a newer one stops.
+func scheduleLiveRefresh(client *fotmob.Client, useMockData bool, gen int, delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(t time.Time) tea.Msg {
 		if useMockData {
-			return liveRefreshMsg{matches: data.MockLiveMatches()}
+			return liveRefreshMsg{matches: data.MockLiveMatches(), gen: gen}
 		}
 
 		if client == nil {
-			return liveRefreshMsg{matches: nil}
+			return liveRefreshMsg{matches: nil, gen: gen}
 		}
 
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -133,42 +134,229 @@ func scheduleLiveRefresh(client *fotmob.Client, useMockData bool) tea.Cmd {
 		// Force refresh to bypass cache
 		matches, err := client.LiveMatchesForceRefresh(ctx)
 		if err != nil {
-			return liveRefreshMsg{matches: nil}
+			return liveRefreshMsg{matches: nil, gen: gen}
 		}
 
-		return liveRefreshMsg{matches: matches}
+		return liveRefreshMsg{matches: matches, gen: gen}
 	})
 }
 
-// fetchMatchDetails fetches match details from the API.
+// fetchMatchPreview fetches details for an upcoming match together with its league table.
+// The table is skipped when already cached by the caller (haveTable) or unavailable - the
+// preview then simply omits league positions.
+func fetchMatchPreview(client *fotmob.Client, matchID, leagueID int, haveTable bool, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			details, _ := data.MockMatchDetails(matchID)
+			return matchPreviewMsg{matchID: matchID, leagueID: leagueID, details: details}
+		}
+
+		if client == nil {
+			return matchPreviewMsg{matchID: matchID, leagueID: leagueID}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		details, err := client.MatchDetails(ctx, matchID)
+		if err != nil {
+			return matchPreviewMsg{matchID: matchID, leagueID: leagueID}
+		}
+
+		var table []api.LeagueTableEntry
+		if !haveTable && leagueID != 0 {
+			// Best-effort: positions are a nice-to-have, so errors are ignored
+			table, _ = client.LeagueTable(ctx, leagueID)
+		}
+
+		return matchPreviewMsg{matchID: matchID, leagueID: leagueID, details: details, table: table}
+	}
+}
+
+// fetchTeamDetails fetches a team's overview for the team view.
 // Returns mock data if useMockData is true, otherwise uses real API.
-func fetchMatchDetails(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+func fetchTeamDetails(client *fotmob.Client, teamID int, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			details, _ := data.MockTeamDetails(teamID)
+			return teamDetailsMsg{teamID: teamID, details: details}
+		}
+
+		if client == nil {
+			return teamDetailsMsg{teamID: teamID}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		details, err := client.TeamDetails(ctx, teamID)
+		if err != nil {
+			return teamDetailsMsg{teamID: teamID}
+		}
+
+		return teamDetailsMsg{teamID: teamID, details: details}
+	}
+}
+
+// fetchStandings fetches a league's table, stat leaderboards, knockout bracket and live matches
+// for the standings view.
+// The table, leaders and bracket come from one league page; if it fails the error is reported
+// instead. Live matches only feed the projected table, so a failure there is ignored.
+func fetchStandings(client *fotmob.Client, leagueID int, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			table, _ := data.MockLeagueTables(leagueID)
+			leaders, _ := data.MockLeagueLeaders(leagueID)
+			bracket, _ := data.MockLeagueBracket(leagueID)
+			return standingsMsg{leagueID: leagueID, table: table, leaders: leaders, bracket: bracket, live: mockLeagueLiveMatches(leagueID)}
+		}
+
+		if client == nil {
+			return standingsMsg{leagueID: leagueID}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		// The client caches the league page, so leaders and bracket reuse the table's response
+		table, err := client.LeagueTables(ctx, leagueID)
+		if err != nil {
+			return standingsMsg{leagueID: leagueID, err: err}
+		}
+		leaders, err := client.LeagueLeaders(ctx, leagueID)
+		if err != nil {
+			return standingsMsg{leagueID: leagueID, err: err}
+		}
+		bracket, err := client.LeagueBracket(ctx, leagueID)
+		if err != nil {
+			return standingsMsg{leagueID: leagueID, err: err}
+		}
+
+		liveCtx, liveCancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer liveCancel()
+		live, _ := client.LiveMatchesForLeague(liveCtx, leagueID)
+
+		return standingsMsg{leagueID: leagueID, table: table, leaders: leaders, bracket: bracket, live: live}
+	}
+}
+
+// fetchStandingsLive fetches a league's live matches to refresh its projected table.
+func fetchStandingsLive(client *fotmob.Client, leagueID, gen int, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			return standingsLiveMsg{leagueID: leagueID, gen: gen, live: mockLeagueLiveMatches(leagueID), ok: true}
+		}
+
+		if client == nil {
+			return standingsLiveMsg{leagueID: leagueID, gen: gen}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		live, err := client.LiveMatchesForLeague(ctx, leagueID)
+		if err != nil {
+			return standingsLiveMsg{leagueID: leagueID, gen: gen}
+		}
+
+		return standingsLiveMsg{leagueID: leagueID, gen: gen, live: live, ok: true}
+	}
+}
+
+// mockLeagueLiveMatches returns the in-progress mock matches for a league.
+func mockLeagueLiveMatches(leagueID int) []api.Match {
+	var live []api.Match
+	for _, match := range data.MockLiveMatches() {
+		if match.League.ID == leagueID && match.Status == api.MatchStatusLive {
+			live = append(live, match)
+		}
+	}
+	return live
+}
+
+// fetchTieMatch fetches details for a knockout leg opened from the bracket.
+func fetchTieMatch(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
 			details, _ := data.MockMatchDetails(matchID)
-			return matchDetailsMsg{details: details}
+			return tieMatchMsg{matchID: matchID, details: details}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if client == nil {
+			return tieMatchMsg{matchID: matchID}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 		defer cancel()
 
 		details, err := client.MatchDetails(ctx, matchID)
 		if err != nil {
-			return matchDetailsMsg{details: nil}
+			return tieMatchMsg{matchID: matchID}
 		}
 
-		return matchDetailsMsg{details: details}
+		return tieMatchMsg{matchID: matchID, details: details}
+	}
+}
+
+// fetchPlayer fetches a player's profile for the player view.
+// name is only used in mock mode, where events carry synthetic player IDs.
+func fetchPlayer(client *fotmob.Client, playerID int, name string, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			details, _ := data.MockPlayer(playerID, name)
+			return playerDetailsMsg{playerID: playerID, details: details}
+		}
+
+		if client == nil {
+			return playerDetailsMsg{playerID: playerID}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		details, err := client.Player(ctx, playerID)
+		if err != nil {
+			return playerDetailsMsg{playerID: playerID}
+		}
+
+		return playerDetailsMsg{playerID: playerID, details: details}
 	}
 }
 
-// schedulePollTick schedules the next poll after 90 seconds.
-// When the tick fires, it sends pollTickMsg which triggers the actual API call.
-func schedulePollTick(matchID int) tea.Cmd {
-	return tea.Tick(90*time.Second, func(t time.Time) tea.Msg {
-		return pollTickMsg{matchID: matchID}
+// waitForLiveWatch waits for the selected match's next update.
+// Re-issued after every update; returns nil once the stream is closed.
+func waitForLiveWatch(updates <-chan fotmob.MatchUpdate, gen int) tea.Cmd {
+	return func() tea.Msg {
+		update, ok := <-updates
+		if !ok {
+			return nil
+		}
+		return liveWatchMsg{gen: gen, update: update}
+	}
+}
+
+// ClockTickInterval is how often running match clocks are re-rendered between polls.
+const ClockTickInterval = 5 * time.Second
+
+// scheduleClockTick schedules the next match clock re-render.
+func scheduleClockTick() tea.Cmd {
+	return tea.Tick(ClockTickInterval, func(t time.Time) tea.Msg {
+		return clockTickMsg{}
 	})
 }
 
+// waitForWatcherUpdate waits for the background watcher's next poll.
+// Re-issued after every update; returns nil once the watcher has stopped.
+func waitForWatcherUpdate(w *watcher.Watcher) tea.Cmd {
+	return func() tea.Msg {
+		update, ok := <-w.Updates()
+		if !ok {
+			return nil
+		}
+		return watcherUpdateMsg{update: update}
+	}
+}
+
 // PollSpinnerDuration is how long to show the "Updating..." spinner.
 const PollSpinnerDuration = 1 * time.Second
 
@@ -179,26 +367,46 @@ func schedulePollSpinnerHide() tea.Cmd {
 	})
 }
 
-// fetchPollMatchDetails fetches match details for a poll refresh.
-// This is called when pollTickMsg is received, with loading state visible.
-// Uses force refresh to bypass cache and ensure fresh data for live matches.
-func fetchPollMatchDetails(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+// fetchCommentary fetches the live text commentary for a match.
+// Sent alongside each live details load and poll; failures leave the feed unchanged.
+func fetchCommentary(client *fotmob.Client, matchID int, homeTeam, awayTeam string, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
-			details, _ := data.MockMatchDetails(matchID)
-			return matchDetailsMsg{details: details}
+			return commentaryMsg{matchID: matchID, entries: data.MockCommentary(matchID)}
+		}
+
+		if client == nil {
+			return commentaryMsg{matchID: matchID}
 		}
 
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		// Force refresh to bypass cache - live matches need fresh data
-		details, err := client.MatchDetailsForceRefresh(ctx, matchID)
+		entries, err := client.Commentary(ctx, matchID, homeTeam, awayTeam)
 		if err != nil {
-			return matchDetailsMsg{details: nil}
+			return commentaryMsg{matchID: matchID}
 		}
 
-		return matchDetailsMsg{details: details}
+		return commentaryMsg{matchID: matchID, entries: entries}
+	}
+}
+
+// searchTeams searches teams by name for the followed teams settings.
+func searchTeams(client *fotmob.Client, query string, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			return teamSearchMsg{query: query, results: data.MockSearchTeams(query)}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		results, err := client.SearchTeams(ctx, query)
+		if err != nil {
+			return teamSearchMsg{query: query}
+		}
+
+		return teamSearchMsg{query: query, results: results}
 	}
 }
 
@@ -273,7 +481,7 @@ func fetchStatsDayData(client *fotmob.Client, useMockData bool, dayIndex int, to
 		for _, match := range matches {
 			if match.Status == api.MatchStatusFinished {
 				finished = append(finished, match)
-			} else if match.Status == api.MatchStatusNotStarted && isToday {
+			} else if (match.Status == api.MatchStatusNotStarted || match.Status == api.MatchStatusPostponed) && isToday {
 				upcoming = append(upcoming, match)
 			}
 		}
@@ -324,7 +532,7 @@ func fetchGoalLinks(redditClient *reddit.Client, details *api.MatchDetails) tea.
 		// Extract goal events from match details
 		var goals []reddit.GoalInfo
 		for _, event := range details.Events {
-			if event.Type != "goal" {
+			if !event.Kind.IsGoal() {
 				continue
 			}
 
@@ -2,11 +2,17 @@ package app
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/notify"
+	"github.com/0xjuanma/golazo/internal/standings"
 	"github.com/0xjuanma/golazo/internal/ui"
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -16,20 +22,27 @@ import (
 func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "j", "down":
-		if m.selected < 2 && !m.mainViewLoading { // 3 menu items: 0, 1, 2
+		if m.selected < 3 && !m.mainViewLoading { // 4 menu items: 0, 1, 2, 3
 			m.selected++
 		}
 	case "k", "up":
 		if m.selected > 0 && !m.mainViewLoading {
 			m.selected--
 		}
+	case "z":
+		return m.cycleSnooze()
 	case "enter":
 		if m.mainViewLoading {
 			return m, nil
 		}
 
-		// Handle Settings view separately (no API calls needed)
+		// Standings view fetches per league on demand
 		if m.selected == 2 {
+			return m.openStandingsView()
+		}
+
+		// Handle Settings view separately (no API calls needed)
+		if m.selected == 3 {
 			m.settingsState = ui.NewSettingsState()
 			m.currentView = viewSettings
 			return m, nil
@@ -43,10 +56,8 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.upcomingMatches = nil
 		m.matchDetails = nil
 		m.liveUpdates = nil
-		m.lastEvents = nil
-		m.lastHomeScore = 0
-		m.lastAwayScore = 0
-		m.polling = false
+		m.disallowedGoals = nil
+		m.stopLiveWatch()
 		m.upcomingMatchesList.SetItems([]list.Item{})
 		m.matchDetailsCache = make(map[int]*api.MatchDetails)
 
@@ -74,6 +85,11 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			totalLeagues := fotmob.TotalLeagues()
 			m.liveTotalBatches = (totalLeagues + LiveBatchSize - 1) / LiveBatchSize // Ceiling division
 			m.liveMatchesBuffer = nil                                               // Clear buffer
+			m.liveUpcomingMatches = nil
+			m.liveUpcomingFocused = false
+			m.liveDetailsFocused = false
+			m.liveUpcomingSelected = 0
+			m.previewDetails = nil
 			m.liveMatchesList.SetItems([]list.Item{})
 			cmds = append(cmds, ui.SpinnerTick())
 			// Start fetching batch 0 (4 leagues in parallel) - results shown when batch completes
@@ -108,6 +124,55 @@ func (m model) handleLiveMatchesKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleUpcomingKeys processes keyboard input while the upcoming matches list is focused.
+// Moving the selection loads the preview for the newly selected match.
+func (m model) handleUpcomingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "j", "down":
+		if m.liveUpcomingSelected < len(m.visibleUpcoming())-1 {
+			m.liveUpcomingSelected++
+			return m.loadMatchPreview()
+		}
+	case "k", "up":
+		if m.liveUpcomingSelected > 0 {
+			m.liveUpcomingSelected--
+			return m.loadMatchPreview()
+		}
+	}
+	return m, nil
+}
+
+// loadMatchPreview loads the pre-match preview for the selected upcoming match.
+// League tables are fetched once per league and reused for later previews.
+// Previews open from the live view only: the stats view lists finished matches, and
+// today's upcoming ones it loads (TodayUpcoming) are shown in the live view's upcoming list.
+func (m model) loadMatchPreview() (tea.Model, tea.Cmd) {
+	upcoming := m.visibleUpcoming()
+	if m.liveUpcomingSelected < 0 || m.liveUpcomingSelected >= len(upcoming) {
+		return m, nil
+	}
+
+	match := upcoming[m.liveUpcomingSelected]
+	_, haveTable := m.previewTables[match.League.ID]
+
+	m.previewDetails = nil
+	m.previewLoadingID = match.ID
+	m.loading = true
+	m.liveViewLoading = true
+	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchMatchPreview(m.fotmobClient, match.ID, match.League.ID, haveTable, m.useMockData))
+}
+
+// stopPreviewLoading clears the spinner of a preview still loading, for when focus leaves
+// the upcoming list before it arrives.
+func (m *model) stopPreviewLoading() {
+	if m.previewLoadingID == 0 {
+		return
+	}
+	m.previewLoadingID = 0
+	m.loading = false
+	m.liveViewLoading = false
+}
+
 // handleStatsViewKeys processes keyboard input for the stats view.
 // Handles date range navigation (left/right) to change the time period.
 // Uses client-side filtering from cached data - no new API calls needed!
@@ -166,23 +231,118 @@ func (m model) handleStatsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsDayData(m.fotmobClient, m.useMockData, 0, fotmob.StatsDataDays))
 }
 
+// cycleSnooze moves the notification snooze on by one step: off, muted for
+// notify.SnoozeDuration, until the open live match ends (when one is open), off again.
+func (m model) cycleSnooze() (tea.Model, tea.Cmd) {
+	if m.notifier == nil {
+		return m, nil
+	}
+	until, matchID := m.notifier.Snoozed()
+	switch {
+	case until.IsZero():
+		m.notifier.Snooze(notify.SnoozeDuration)
+	case matchID == 0 && m.openLiveMatchID() != 0:
+		m.notifier.SnoozeMatch(m.openLiveMatchID())
+	default:
+		m.notifier.Unsnooze()
+	}
+	return m, nil
+}
+
+// openLiveMatchID returns the ID of the in-play match open in the live view, or 0.
+func (m model) openLiveMatchID() int {
+	if m.currentView != viewLiveMatches || m.liveUpcomingFocused || m.matchDetails == nil || !m.matchDetails.State.InPlay() {
+		return 0
+	}
+	return m.matchDetails.ID
+}
+
+// toggleMyTeams switches the My teams filter and rebuilds the open match list,
+// keeping the selected match when it is still listed.
+// Does nothing until teams are followed in the settings.
+func (m model) toggleMyTeams() (tea.Model, tea.Cmd) {
+	if len(m.followedTeams) == 0 {
+		return m, nil
+	}
+	m.myTeamsOnly = !m.myTeamsOnly
+
+	// The list status bar tells the filter is on ("3 followed matches")
+	singular, plural := "item", "items"
+	if m.myTeamsOnly {
+		singular, plural = "followed match", "followed matches"
+	}
+	m.liveMatchesList.SetStatusBarItemName(singular, plural)
+	m.statsMatchesList.SetStatusBarItemName(singular, plural)
+
+	currentMatchID := 0
+	if m.matchDetails != nil {
+		currentMatchID = m.matchDetails.ID
+	}
+
+	if m.currentView == viewStats {
+		m.applyStatsDateFilter()
+		m.selected = 0
+		for i, match := range m.matches {
+			if match.ID == currentMatchID {
+				m.selected = i
+			}
+		}
+		if len(m.matches) == 0 {
+			return m, nil
+		}
+		m.statsMatchesList.Select(m.selected)
+		if m.matches[m.selected].ID != currentMatchID {
+			return m.loadStatsMatchDetails(m.matches[m.selected].ID)
+		}
+		return m, nil
+	}
+
+	m.setLiveMatches(m.liveMatchesAll)
+	m.updateLiveListSize()
+	m.selectLiveMatch(currentMatchID)
+	m.liveUpcomingSelected = max(min(m.liveUpcomingSelected, len(m.visibleUpcoming())-1), 0)
+	if len(m.matches) > 0 && m.matches[m.selected].ID != currentMatchID {
+		return m.loadMatchDetails(m.matches[m.selected].ID)
+	}
+	return m, nil
+}
+
 // loadMatchDetails loads match details for the live matches view.
-// Resets live updates and event history before fetching new details.
+// Resets live updates and event history, then follows the match through the background watcher.
 func (m model) loadMatchDetails(matchID int) (tea.Model, tea.Cmd) {
 	m.liveUpdates = nil
-	m.lastEvents = nil
-	m.lastHomeScore = 0
-	m.lastAwayScore = 0
+	m.commentary = nil
+	m.commentaryNew = 0
+	m.timelineFilter.ClearMatch()
+	m.disallowedGoals = nil
+	m.previewLoadingID = 0 // The details load owns the spinner now
 	m.loading = true
 	m.liveViewLoading = true
-	m.polling = false // Reset polling state - this is a new match load, not a poll refresh
-	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchMatchDetails(m.fotmobClient, matchID, m.useMockData))
+
+	// Replace the previous match's subscription; its first update is the initial load
+	m.stopLiveWatch()
+	m.liveWatchGen++
+	m.liveWatch, m.liveWatchCancel = m.watcher.Subscribe(matchID)
+	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), waitForLiveWatch(m.liveWatch, m.liveWatchGen))
+}
+
+// stopLiveWatch ends the subscription to the selected match's stream, if any.
+// The watcher keeps following the match if it is live.
+func (m *model) stopLiveWatch() {
+	if m.liveWatchCancel != nil {
+		m.liveWatchCancel()
+	}
+	m.liveWatch = nil
+	m.liveWatchCancel = nil
+	m.polling = false
 }
 
 // loadStatsMatchDetails loads match details for the stats view.
 // Checks cache first to avoid redundant API calls.
 func (m model) loadStatsMatchDetails(matchID int) (tea.Model, tea.Cmd) {
 	m.debugLog(fmt.Sprintf("Loading match details for ID: %d", matchID))
+	m.timelineFilter.ClearMatch()
+	m.statsScrollOffset = 0
 
 	// Return cached details if available
 	if cached, ok := m.matchDetailsCache[matchID]; ok {
@@ -198,6 +358,82 @@ func (m model) loadStatsMatchDetails(matchID int) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsMatchDetailsFotmob(m.fotmobClient, matchID, m.useMockData))
 }
 
+// handleTimelineKeys handles the timeline filter keys while a details panel has focus:
+// 1-4 toggle goals/cards/subs/VAR, 0 clears all filters, / searches by player and g jumps to a minute.
+// Returns false when the key is not a timeline key.
+func (m model) handleTimelineKeys(msg tea.KeyMsg) (model, bool) {
+	if m.timelineFilter.Toggle(msg.String()) {
+		m.statsScrollOffset = 0
+		return m, true
+	}
+
+	switch msg.String() {
+	case "0":
+		m.timelineFilter = ui.TimelineFilter{}
+	case "/":
+		m.timelineFilter.Input = ui.TimelineInputPlayer
+		m.timelineFilter.InputValue = m.timelineFilter.Player
+	case "g":
+		m.timelineFilter.Input = ui.TimelineInputMinute
+		m.timelineFilter.InputValue = ""
+	default:
+		return m, false
+	}
+	m.statsScrollOffset = 0
+	return m, true
+}
+
+// timelineFilterFor returns the session's timeline filter as shown by a details panel with the given focus.
+func (m model) timelineFilterFor(focused bool) ui.TimelineFilter {
+	filter := m.timelineFilter
+	filter.Focused = focused
+	return filter
+}
+
+// handleTimelineInput edits the player search or jump-to-minute field.
+// The player search applies as you type; Enter keeps it and Esc clears it.
+func (m model) handleTimelineInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	filter := &m.timelineFilter
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		if filter.Input == ui.TimelineInputPlayer {
+			filter.Player = ""
+		}
+		filter.Input = ui.TimelineInputNone
+		filter.InputValue = ""
+	case tea.KeyEnter:
+		if filter.Input == ui.TimelineInputMinute {
+			minute, err := strconv.Atoi(strings.TrimSpace(filter.InputValue))
+			if err == nil && minute >= 0 {
+				filter.FromMinute = minute
+			}
+		}
+		filter.Input = ui.TimelineInputNone
+		filter.InputValue = ""
+	case tea.KeyBackspace:
+		if runes := []rune(filter.InputValue); len(runes) > 0 {
+			filter.InputValue = string(runes[:len(runes)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		text := string(msg.Runes)
+		if msg.Type == tea.KeySpace {
+			text = " "
+		}
+		if filter.Input == ui.TimelineInputMinute && strings.Trim(text, "0123456789") != "" {
+			return m, nil // Minutes are digits only
+		}
+		filter.InputValue += text
+	}
+
+	if filter.Input == ui.TimelineInputPlayer {
+		filter.Player = strings.TrimSpace(filter.InputValue)
+	}
+	m.statsScrollOffset = 0
+	return m, nil
+}
+
 // handleSettingsViewKeys processes keyboard input for the settings view.
 // Follows the same pattern as handleStatsSelection for consistent behavior.
 func (m model) handleSettingsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
@@ -220,9 +456,45 @@ func (m model) handleSettingsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case "left", "h": // Left arrow or 'h' to previous tab
 			m.settingsState.PreviousRegion()
 			return m, nil
+		case "/":
+			// The Teams tab searches with its own input instead of filtering the list
+			if m.settingsState.OnTeamsTab() {
+				m.settingsState.FocusSearch()
+				return m, textinput.Blink
+			}
+		case "1", "2", "3", "4", "5", "6", "7", "8", "9", "0":
+			// Event kinds of the highlighted notification rule; 0 is the tenth
+			if m.settingsState.OnRulesTab() {
+				m.settingsState.ToggleRuleEvent((int(msg.String()[0]-'0') + 9) % 10)
+				return m, nil
+			}
+		case "w":
+			if m.settingsState.OnRulesTab() {
+				m.settingsState.CycleRuleCondition()
+				return m, nil
+			}
+		case "c":
+			if m.settingsState.OnRulesTab() {
+				m.settingsState.CycleRuleChannel()
+				return m, nil
+			}
+		case "a":
+			if m.settingsState.OnRulesTab() {
+				m.settingsState.AddRule()
+				return m, nil
+			}
+		case "x":
+			if m.settingsState.OnRulesTab() {
+				m.settingsState.DeleteRule()
+				return m, nil
+			}
 		case "enter":
 			// Save settings and return to main menu
 			_ = m.settingsState.Save() // Best-effort save
+			m.followedTeams = data.FollowedTeamIDs()
+			if settings, err := data.LoadSettings(); err == nil {
+				_ = m.notifier.Configure(settings) // Bad quiet hours are left off
+			}
 			m.settingsState = nil
 			m.currentView = viewMain
 			m.selected = 0
@@ -235,3 +507,409 @@ func (m model) handleSettingsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	m.settingsState.List, listCmd = m.settingsState.List.Update(msg)
 	return m, listCmd
 }
+
+// handleTeamSearchInput edits the settings team search while its input has focus.
+// Enter runs the search, Esc leaves the input.
+func (m model) handleTeamSearchInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	state := m.settingsState
+	switch msg.String() {
+	case "enter":
+		state.Search.Blur()
+		query := strings.TrimSpace(state.Search.Value())
+		if query == "" {
+			return m, nil
+		}
+		state.Search.SetValue(query)
+		state.Searching = true
+		return m, searchTeams(m.fotmobClient, query, m.useMockData)
+	case "esc":
+		state.Search.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	state.Search, cmd = state.Search.Update(msg)
+	return m, cmd
+}
+
+// openStandingsView opens the standings view with a tab per active league.
+// Tables and leaderboards are fetched per league the first time its tab is shown.
+func (m model) openStandingsView() (tea.Model, tea.Cmd) {
+	m.currentView = viewStandings
+	m.standingsLeagues = fotmob.ActiveLeagues()
+	m.standingsLeagueIdx = 0
+	m.standingsTab = ui.StandingsTabTable
+	m.standingsTables = make(map[int][]api.TableGroup)
+	m.standingsLeaders = make(map[int][]api.LeaderboardCategory)
+	m.standingsBrackets = make(map[int][]api.BracketRound)
+	m.standingsLive = make(map[int][]api.Match)
+	m.standingsErrors = make(map[int]error)
+	return m.loadStandings()
+}
+
+// loadStandings fetches the selected league's standings unless already loaded.
+// Leagues with live matches get their scores refreshed straight away instead.
+func (m model) loadStandings() (tea.Model, tea.Cmd) {
+	m.standingsScroll = 0
+	m.standingsPollGen++
+	if m.standingsLeagueIdx >= len(m.standingsLeagues) {
+		return m, nil
+	}
+
+	leagueID := m.standingsLeagues[m.standingsLeagueIdx]
+	if _, ok := m.standingsTables[leagueID]; ok {
+		m.standingsLoading = false
+		if len(m.standingsLive[leagueID]) > 0 {
+			return m, fetchStandingsLive(m.fotmobClient, leagueID, m.standingsPollGen, m.useMockData)
+		}
+		return m, nil
+	}
+
+	m.standingsLoading = true
+	return m, tea.Batch(ui.SpinnerTick(), fetchStandings(m.fotmobClient, leagueID, m.useMockData))
+}
+
+// handleStandingsViewKeys processes keyboard input for the standings view.
+// h/l switch league, Tab cycles table, leaderboards and bracket, j/k scroll.
+// In the bracket, j/k select a tie and Enter opens it.
+func (m model) handleStandingsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "l", "right":
+		if len(m.standingsLeagues) > 0 {
+			m.standingsLeagueIdx = (m.standingsLeagueIdx + 1) % len(m.standingsLeagues)
+			return m.loadStandings()
+		}
+	case "h", "left":
+		if len(m.standingsLeagues) > 0 {
+			m.standingsLeagueIdx = (m.standingsLeagueIdx - 1 + len(m.standingsLeagues)) % len(m.standingsLeagues)
+			return m.loadStandings()
+		}
+	case "tab":
+		m.standingsTab = (m.standingsTab + 1) % ui.StandingsTab(ui.StandingsTabCount)
+		m.standingsScroll = 0
+	case "enter":
+		if m.standingsTab == ui.StandingsTabBracket && m.standingsLeagueIdx < len(m.standingsLeagues) {
+			rounds := m.standingsBrackets[m.standingsLeagues[m.standingsLeagueIdx]]
+			if tie, stage, ok := ui.BracketTieAt(rounds, m.standingsScroll); ok {
+				return m.openTieView(tie, stage)
+			}
+		}
+	case "j", "down":
+		if m.standingsScroll < m.standingsContentLength()-1 {
+			m.standingsScroll++
+		}
+	case "k", "up":
+		if m.standingsScroll > 0 {
+			m.standingsScroll--
+		}
+	}
+	return m, nil
+}
+
+// standingsContentLength returns the number of scrollable lines in the current standings tab.
+func (m model) standingsContentLength() int {
+	if m.standingsLeagueIdx >= len(m.standingsLeagues) {
+		return 0
+	}
+	leagueID := m.standingsLeagues[m.standingsLeagueIdx]
+
+	switch m.standingsTab {
+	case ui.StandingsTabTable:
+		// A single table scrolls by row; groups page by group
+		groups, _, _ := m.projectedStandings(leagueID)
+		if len(groups) == 1 {
+			return len(groups[0].Entries)
+		}
+		return len(groups)
+	case ui.StandingsTabBracket:
+		return ui.BracketTieCount(m.standingsBrackets[leagueID])
+	}
+	lines := 0
+	for i, category := range m.standingsLeaders[leagueID] {
+		if i > 0 {
+			lines++ // Blank line between categories
+		}
+		lines += 1 + len(category.Entries)
+	}
+	return lines
+}
+
+// projectedStandings returns the league's table groups with live scores applied as if the
+// matches ended now, along with position changes and teams currently playing.
+// Without live matches the stored tables are returned unchanged.
+func (m model) projectedStandings(leagueID int) ([]api.TableGroup, map[int]int, map[int]bool) {
+	groups := m.standingsTables[leagueID]
+	live := m.standingsLive[leagueID]
+	if len(live) == 0 {
+		return groups, nil, nil
+	}
+
+	rules := standings.RulesForLeague(leagueID)
+	projected := make([]api.TableGroup, 0, len(groups))
+	movement := make(map[int]int)
+	liveTeams := make(map[int]bool)
+	for _, group := range groups {
+		p := standings.Project(group.Entries, live, rules)
+		projected = append(projected, api.TableGroup{Name: group.Name, Entries: p.Entries})
+		for id, moved := range p.Movement {
+			movement[id] = moved
+		}
+		for id := range p.Live {
+			liveTeams[id] = true
+		}
+	}
+	return projected, movement, liveTeams
+}
+
+// openTieView opens a knockout tie from the bracket, showing the latest leg that has
+// started (or the first leg if none has).
+func (m model) openTieView(tie api.BracketTie, stage string) (tea.Model, tea.Cmd) {
+	if len(tie.Legs) == 0 {
+		return m, nil
+	}
+
+	m.tie = &tie
+	m.tieStage = stage
+	m.tieLeg = 0
+	for i, leg := range tie.Legs {
+		if leg.Status != api.MatchStatusNotStarted {
+			m.tieLeg = i
+		}
+	}
+	m.currentView = viewTie
+	return m.loadTieLeg()
+}
+
+// loadTieLeg fetches details for the selected leg of the open tie.
+func (m model) loadTieLeg() (tea.Model, tea.Cmd) {
+	m.tieDetails = nil
+	if m.tie == nil || m.tieLeg >= len(m.tie.Legs) || m.tie.Legs[m.tieLeg].ID == 0 {
+		m.tieLoading = false
+		return m, nil
+	}
+	m.tieLoading = true
+	return m, tea.Batch(ui.SpinnerTick(), fetchTieMatch(m.fotmobClient, m.tie.Legs[m.tieLeg].ID, m.useMockData))
+}
+
+// handleTieViewKeys processes keyboard input for the tie view.
+// h/l switch legs; 't' and 'p' open the team and player views for the shown leg.
+func (m model) handleTieViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.tie == nil {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "l", "right":
+		if m.tieLeg < len(m.tie.Legs)-1 {
+			m.tieLeg++
+			return m.loadTieLeg()
+		}
+	case "h", "left":
+		if m.tieLeg > 0 {
+			m.tieLeg--
+			return m.loadTieLeg()
+		}
+	case "t":
+		return m.openTeamView(m.tieDetails)
+	case "p":
+		return m.openPlayerPicker(m.matchPlayerCandidates(m.tieDetails))
+	}
+	return m, nil
+}
+
+// openTeamView opens the team page for the home team of the given match.
+// Pressing 't' again in the team view switches to the away team.
+func (m model) openTeamView(details *api.MatchDetails) (tea.Model, tea.Cmd) {
+	if details == nil || details.HomeTeam.ID == 0 {
+		return m, nil
+	}
+
+	m.teamReturnView = m.currentView
+	m.teamMatchTeams = [2]int{details.HomeTeam.ID, details.AwayTeam.ID}
+	m.teamShowingAway = false
+	m.currentView = viewTeam
+	return m.loadTeamDetails(details.HomeTeam.ID)
+}
+
+// loadTeamDetails fetches the team page for teamID and resets scroll state.
+func (m model) loadTeamDetails(teamID int) (tea.Model, tea.Cmd) {
+	m.teamDetails = nil
+	m.teamScrollOffset = 0
+	m.teamViewLoading = true
+	return m, tea.Batch(ui.SpinnerTick(), fetchTeamDetails(m.fotmobClient, teamID, m.useMockData))
+}
+
+// handleTeamViewKeys processes keyboard input for the team view.
+// 't' switches between the two teams of the originating match; j/k scroll the squad;
+// 'p' opens a player from the squad.
+func (m model) handleTeamViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "p":
+		return m.openPlayerPicker(teamPlayerCandidates(m.teamDetails))
+	case "t":
+		if m.teamViewLoading || m.teamMatchTeams[1] == 0 {
+			return m, nil
+		}
+		m.teamShowingAway = !m.teamShowingAway
+		teamID := m.teamMatchTeams[0]
+		if m.teamShowingAway {
+			teamID = m.teamMatchTeams[1]
+		}
+		return m.loadTeamDetails(teamID)
+	case "j", "down":
+		if m.teamDetails != nil && m.teamScrollOffset < len(m.teamDetails.Squad)-1 {
+			m.teamScrollOffset++
+		}
+	case "k", "up":
+		if m.teamScrollOffset > 0 {
+			m.teamScrollOffset--
+		}
+	}
+	return m, nil
+}
+
+// openPlayerPicker opens the player view with a picker over the given candidates.
+// With a single candidate the profile is loaded straight away.
+func (m model) openPlayerPicker(candidates []ui.PlayerCandidate) (tea.Model, tea.Cmd) {
+	if len(candidates) == 0 {
+		return m, nil
+	}
+
+	m.playerReturnView = m.currentView
+	m.playerCandidates = candidates
+	m.playerPickerSelected = 0
+	m.playerDetails = nil
+	m.currentView = viewPlayer
+
+	if len(candidates) == 1 {
+		return m.loadPlayer(candidates[0])
+	}
+	m.playerPicking = true
+	return m, nil
+}
+
+// loadPlayer fetches the profile for the given candidate.
+func (m model) loadPlayer(candidate ui.PlayerCandidate) (tea.Model, tea.Cmd) {
+	m.playerPicking = false
+	m.playerDetails = nil
+	m.playerViewLoading = true
+	return m, tea.Batch(ui.SpinnerTick(), fetchPlayer(m.fotmobClient, candidate.ID, candidate.Name, m.useMockData))
+}
+
+// handlePlayerViewKeys processes keyboard input for the player view.
+// In the picker, j/k select and Enter opens the profile.
+func (m model) handlePlayerViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if !m.playerPicking {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "j", "down":
+		if m.playerPickerSelected < len(m.playerCandidates)-1 {
+			m.playerPickerSelected++
+		}
+	case "k", "up":
+		if m.playerPickerSelected > 0 {
+			m.playerPickerSelected--
+		}
+	case "enter":
+		if m.playerPickerSelected < len(m.playerCandidates) {
+			return m.loadPlayer(m.playerCandidates[m.playerPickerSelected])
+		}
+	}
+	return m, nil
+}
+
+// matchPlayerCandidates builds the player picker list for a match.
+// Order: last notified scorer, event players (newest first), then starting lineups.
+// Players without a known ID are skipped since their profile can't be fetched.
+func (m model) matchPlayerCandidates(details *api.MatchDetails) []ui.PlayerCandidate {
+	if details == nil {
+		return nil
+	}
+
+	var candidates []ui.PlayerCandidate
+	seen := make(map[int]bool)
+	add := func(c ui.PlayerCandidate) {
+		if c.ID == 0 || seen[c.ID] {
+			return
+		}
+		seen[c.ID] = true
+		candidates = append(candidates, c)
+	}
+
+	if m.lastNotifiedScorer != nil {
+		add(*m.lastNotifiedScorer)
+	}
+
+	for i := len(details.Events) - 1; i >= 0; i-- {
+		event := details.Events[i]
+		if event.Player == nil || event.Kind == api.EventAddedTime || event.Kind == api.EventVAR {
+			continue
+		}
+		minute := event.DisplayMinute
+		if minute == "" {
+			minute = fmt.Sprintf("%d'", event.Minute)
+		}
+
+		// Own goals are credited to the other team than the scorer's
+		team := event.Team.ShortName
+		if event.Kind == api.EventOwnGoal {
+			team = details.AwayTeam.ShortName
+			if event.Team.ID == details.AwayTeam.ID {
+				team = details.HomeTeam.ShortName
+			}
+		}
+
+		label := strings.ToLower(event.Kind.Label())
+		if event.Kind == api.EventSubstitution {
+			label = "sub off"
+		}
+		add(ui.PlayerCandidate{
+			ID:     event.PlayerID,
+			Name:   *event.Player,
+			Team:   team,
+			Detail: strings.TrimSpace(fmt.Sprintf("%s %s", minute, label)),
+		})
+		if event.PlayerIn != nil {
+			add(ui.PlayerCandidate{
+				ID:     event.PlayerInID,
+				Name:   *event.PlayerIn,
+				Team:   event.Team.ShortName,
+				Detail: minute + " sub on",
+			})
+		}
+	}
+
+	for _, p := range details.HomeStarting {
+		add(ui.PlayerCandidate{ID: p.ID, Name: p.Name, Team: details.HomeTeam.ShortName, Detail: "Lineup"})
+	}
+	for _, p := range details.AwayStarting {
+		add(ui.PlayerCandidate{ID: p.ID, Name: p.Name, Team: details.AwayTeam.ShortName, Detail: "Lineup"})
+	}
+
+	return candidates
+}
+
+// teamPlayerCandidates builds the player picker list from a team's top scorers and squad.
+func teamPlayerCandidates(team *api.TeamDetails) []ui.PlayerCandidate {
+	if team == nil {
+		return nil
+	}
+
+	var candidates []ui.PlayerCandidate
+	seen := make(map[int]bool)
+	for _, s := range team.TopScorers {
+		if s.ID != 0 && !seen[s.ID] {
+			seen[s.ID] = true
+			candidates = append(candidates, ui.PlayerCandidate{ID: s.ID, Name: s.Name, Team: team.ShortName, Detail: fmt.Sprintf("%d goals", s.Goals)})
+		}
+	}
+	for _, p := range team.Squad {
+		if p.ID != 0 && !seen[p.ID] && p.Position != "Coach" {
+			seen[p.ID] = true
+			candidates = append(candidates, ui.PlayerCandidate{ID: p.ID, Name: p.Name, Team: team.ShortName, Detail: p.Position})
+		}
+	}
+	return candidates
+}
@@ -4,11 +4,12 @@ import (
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/fotmob"
 	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/0xjuanma/golazo/internal/watcher"
 )
 
-// liveUpdateMsg contains a live update string for match events.
+// liveUpdateMsg contains new timeline entries for the live updates feed.
 type liveUpdateMsg struct {
-	update string
+	entries []api.TimelineEntry
 }
 
 // matchDetailsMsg contains match details from API response.
@@ -16,14 +17,28 @@ type matchDetailsMsg struct {
 	details *api.MatchDetails
 }
 
+// commentaryMsg contains the live text commentary for a match (nil entries when the fetch failed).
+type commentaryMsg struct {
+	matchID int
+	entries []api.CommentaryEntry
+}
+
+// teamSearchMsg contains the teams found for a settings search (empty when the search failed).
+type teamSearchMsg struct {
+	query   string
+	results []api.TeamSearchResult
+}
+
 // liveMatchesMsg contains live matches from API response.
 type liveMatchesMsg struct {
 	matches []api.Match
 }
 
-// liveRefreshMsg is sent when live matches are refreshed (periodic 5-min timer).
+// liveRefreshMsg is sent when live matches are refreshed (kickoff-aware timer).
+// gen must match the model's liveRefreshGen, otherwise a newer refresh chain has started.
 type liveRefreshMsg struct {
 	matches []api.Match
+	gen     int
 }
 
 // liveBatchDataMsg contains live matches for a batch of leagues (parallel loading).
@@ -32,6 +47,54 @@ type liveBatchDataMsg struct {
 	batchIndex int         // Which batch (0, 1, 2, ...)
 	isLast     bool        // true if this is the last batch
 	matches    []api.Match // live matches from all leagues in this batch
+	upcoming   []api.Match // today's not-started matches from all leagues in this batch
+}
+
+// matchPreviewMsg contains details and league standings for an upcoming match preview.
+// table is nil when it was already cached or could not be fetched.
+type matchPreviewMsg struct {
+	matchID  int
+	leagueID int
+	details  *api.MatchDetails
+	table    []api.LeagueTableEntry
+}
+
+// teamDetailsMsg contains a team's overview for the team view.
+type teamDetailsMsg struct {
+	teamID  int
+	details *api.TeamDetails
+}
+
+// standingsMsg contains a league's table and stat leaderboards for the standings view.
+// err is set when the league page could not be fetched; the other fields are then empty.
+type standingsMsg struct {
+	leagueID int
+	table    []api.TableGroup
+	leaders  []api.LeaderboardCategory
+	bracket  []api.BracketRound
+	live     []api.Match
+	err      error
+}
+
+// standingsLiveMsg contains refreshed live matches for a league's projected table.
+// ok reports the request succeeded: live is then the whole list, and empty once no match is in play.
+type standingsLiveMsg struct {
+	leagueID int
+	gen      int
+	live     []api.Match
+	ok       bool
+}
+
+// tieMatchMsg contains details for a leg opened from the knockout bracket.
+type tieMatchMsg struct {
+	matchID int
+	details *api.MatchDetails
+}
+
+// playerDetailsMsg contains a player's profile for the player view.
+type playerDetailsMsg struct {
+	playerID int
+	details  *api.Player
 }
 
 // statsDataMsg contains all stats data (5 days finished + today upcoming) from API response.
@@ -50,10 +113,19 @@ type statsDayDataMsg struct {
 	upcoming []api.Match // upcoming matches (only for today)
 }
 
-// pollTickMsg is sent when the 90-second poll interval elapses.
-// This triggers the actual API call with loading state visible.
-type pollTickMsg struct {
-	matchID int
+// liveWatchMsg carries one update of the selected live match's watch stream.
+// gen identifies the watch; updates from a watch since replaced are dropped.
+type liveWatchMsg struct {
+	gen    int
+	update fotmob.MatchUpdate
+}
+
+// clockTickMsg re-renders running match clocks between polls.
+type clockTickMsg struct{}
+
+// watcherUpdateMsg carries one poll of a live match from the background watcher.
+type watcherUpdateMsg struct {
+	update watcher.Update
 }
 
 // pollDisplayCompleteMsg is sent after minimum display time (1 second) has elapsed.
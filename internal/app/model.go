@@ -2,9 +2,8 @@
 package app
 
 import (
-	"fmt"
-	"os"
-	"path/filepath"
+	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
@@ -14,6 +13,7 @@ import (
 	"github.com/0xjuanma/golazo/internal/notify"
 	"github.com/0xjuanma/golazo/internal/reddit"
 	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/0xjuanma/golazo/internal/watcher"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/viewport"
@@ -28,6 +28,10 @@ const (
 	viewLiveMatches
 	viewStats
 	viewSettings
+	viewTeam
+	viewPlayer
+	viewStandings
+	viewTie
 )
 
 // model holds the application state.
@@ -44,13 +48,20 @@ type model struct {
 	// Match data
 	matches             []ui.MatchDisplay
 	upcomingMatches     []ui.MatchDisplay // Upcoming matches for 1-day stats view (deprecated, kept for compatibility)
+	liveMatchesAll      []api.Match       // Live matches before the My teams filter, so it can be turned off
 	liveUpcomingMatches []ui.MatchDisplay // Upcoming matches for live view (shown at bottom of left panel)
 	matchDetails        *api.MatchDetails
 	matchDetailsCache   map[int]*api.MatchDetails // Cache to avoid repeated API calls
-	liveUpdates         []string
-	lastEvents          []api.MatchEvent
-	lastHomeScore       int // Track last known home score for goal notifications
-	lastAwayScore       int // Track last known away score for goal notifications
+	liveUpdates         []api.TimelineEntry
+	commentary          []api.CommentaryEntry // Live text commentary, newest first
+	commentaryNew       int                   // Entries at the top of commentary added by the latest poll
+	showCommentary      bool                  // Live view shows the commentary feed instead of the updates timeline
+	disallowedGoals     []api.MatchEvent      // Goals removed by the provider since they were first seen, struck through on the timeline
+
+	// The background watcher's stream of the selected live match (see watcher.Watcher.Subscribe)
+	liveWatch       <-chan fotmob.MatchUpdate
+	liveWatchCancel func()
+	liveWatchGen    int // Incremented when a subscription starts, so updates from older ones are dropped
 
 	// Stats data cache - stores 5 days of data, filtered client-side for Today/3d/5d views
 	statsData *fotmob.StatsData
@@ -63,6 +74,14 @@ type model struct {
 	liveBatchesLoaded int         // Number of batches loaded so far
 	liveTotalBatches  int         // Total batches to load
 	liveMatchesBuffer []api.Match // Buffer to accumulate live matches during progressive load
+	liveRefreshGen    int         // Incremented when a live list refresh chain starts, so older chains stop
+
+	// Upcoming match preview state (live view)
+	liveUpcomingFocused  bool                           // Whether the upcoming list has focus instead of the live list
+	liveUpcomingSelected int                            // Selected index in visibleUpcoming()
+	previewDetails       *api.MatchDetails              // Details for the selected upcoming match
+	previewLoadingID     int                            // Match whose preview is loading (0 if none); its spinner is cleared whatever happens to the response
+	previewTables        map[int][]api.LeagueTableEntry // League tables keyed by league ID, for positions
 
 	// UI components
 	spinner          spinner.Model
@@ -74,9 +93,11 @@ type model struct {
 	liveMatchesList        list.Model
 	statsMatchesList       list.Model
 	upcomingMatchesList    list.Model
-	statsDetailsViewport   viewport.Model // Scrollable viewport for match details in stats view
-	statsRightPanelFocused bool           // Whether right panel is focused for scrolling
-	statsScrollOffset      int            // Manual scroll offset for right panel content
+	statsDetailsViewport   viewport.Model    // Scrollable viewport for match details in stats view
+	statsRightPanelFocused bool              // Whether right panel is focused for scrolling
+	statsScrollOffset      int               // Manual scroll offset for right panel content
+	liveDetailsFocused     bool              // Whether the live view's details panel has focus (timeline filters)
+	timelineFilter         ui.TimelineFilter // Event timeline filters, kept for the session
 
 	// Loading states
 	loading          bool
@@ -96,6 +117,43 @@ type model struct {
 	// Settings view state
 	settingsState *ui.SettingsState
 
+	// Team view state (opened from a match with 't')
+	teamDetails      *api.TeamDetails
+	teamViewLoading  bool
+	teamReturnView   view   // View to return to on Esc
+	teamMatchTeams   [2]int // Home and away team IDs of the originating match ('t' toggles)
+	teamShowingAway  bool   // Whether the away team of the originating match is shown
+	teamScrollOffset int    // Scroll offset for the squad panel
+
+	// Player view state (opened with 'p' from match details or the team view)
+	playerCandidates     []ui.PlayerCandidate // Players to pick from
+	playerPickerSelected int                  // Selected candidate in the picker
+	playerPicking        bool                 // Whether the picker (not the profile) is shown
+	playerDetails        *api.Player
+	playerViewLoading    bool
+	playerReturnView     view                // View to return to on Esc
+	lastNotifiedScorer   *ui.PlayerCandidate // Scorer from the most recent goal notification
+
+	// Standings view state (league tables and stat leaderboards for active leagues)
+	standingsLeagues   []int // League IDs shown as tabs, from the active leagues
+	standingsLeagueIdx int   // Selected league tab
+	standingsTab       ui.StandingsTab
+	standingsTables    map[int][]api.TableGroup          // Tables (one per group) keyed by league ID
+	standingsLeaders   map[int][]api.LeaderboardCategory // Leaderboards keyed by league ID
+	standingsBrackets  map[int][]api.BracketRound        // Knockout brackets keyed by league ID
+	standingsLive      map[int][]api.Match               // In-progress matches keyed by league ID, for projected tables
+	standingsErrors    map[int]error                     // Last fetch error keyed by league ID, shown instead of empty tabs
+	standingsPollGen   int                               // Incremented on league switch so stale live refreshes are dropped
+	standingsLoading   bool
+	standingsScroll    int // Scroll offset for the content panel; selected tie in the bracket tab
+
+	// Tie view state (opened from the bracket with Enter)
+	tie        *api.BracketTie
+	tieStage   string
+	tieLeg     int // Selected leg
+	tieDetails *api.MatchDetails
+	tieLoading bool
+
 	// API clients
 	fotmobClient *fotmob.Client
 	parser       *fotmob.LiveUpdateParser
@@ -104,8 +162,14 @@ type model struct {
 	// Goal replay links from Reddit (keyed by matchID:minute)
 	goalLinks map[reddit.GoalLinkKey]*reddit.GoalLink
 
+	// Followed teams (settings): their matches are pinned to the top of match lists and marked
+	followedTeams map[int]bool
+	myTeamsOnly   bool // "My teams" filter: match lists show followed teams' matches only
+
 	// Notifications
-	notifier *notify.DesktopNotifier
+	notifier     *notify.Router   // Sends match events to the channels chosen by the notification rules
+	hookFailedAt *atomic.Int64    // When a command hook last failed (Unix nanoseconds, 0 if never); set by hook workers
+	watcher      *watcher.Watcher // Polls live and about-to-start matches in the followed leagues for notifications and list scores
 }
 
 // New creates a new application model with default values.
@@ -128,6 +192,8 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	pollingSpinner := ui.NewRandomCharSpinner()
 	pollingSpinner.SetWidth(10) // Small spinner for polling indicator
 
+	hookFailedAt := new(atomic.Int64)
+
 	// Initialize list models with custom delegate
 	delegate := ui.NewMatchListDelegate()
 
@@ -171,36 +237,39 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	// Initialize Reddit client (best-effort, nil if fails)
 	var redditClient *reddit.Client
 	if debugMode {
-		redditClient, _ = reddit.NewClientWithDebug(func(message string) {
-			// This will be called by the Reddit client for debug logging
-			// We'll create a model instance to access debugLog, but for now just log directly
-			// This is a bit of a hack, but it works for debug logging
-			configDir, _ := data.ConfigDir()
-			if configDir != "" {
-				logFile := filepath.Join(configDir, "golazo_debug.log")
-				f, err := os.OpenFile(logFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-				if err == nil {
-					defer f.Close()
-					f.WriteString(fmt.Sprintf("%s %s\n", time.Now().Format("2006-01-02 15:04:05"), message))
-				}
-			}
-		})
+		redditClient, _ = reddit.NewClientWithDebug(appendDebugLog)
 	} else {
 		redditClient, _ = reddit.NewClient()
 	}
 
+	// The background watcher shares the client (and its rate limiter) with the views
+	fotmobClient := fotmob.NewClient()
+	watcherSource := watcher.NewFotMobSource(fotmobClient)
+	if useMockData {
+		watcherSource = watcher.NewMockSource()
+	}
+
 	return model{
 		currentView:            viewMain,
 		matchDetailsCache:      make(map[int]*api.MatchDetails),
+		previewTables:          make(map[int][]api.LeagueTableEntry),
+		standingsTables:        make(map[int][]api.TableGroup),
+		standingsLeaders:       make(map[int][]api.LeaderboardCategory),
+		standingsBrackets:      make(map[int][]api.BracketRound),
+		standingsLive:          make(map[int][]api.Match),
+		standingsErrors:        make(map[int]error),
 		useMockData:            useMockData,
 		debugMode:              debugMode,
 		isDevBuild:             isDevBuild,
 		newVersionAvailable:    newVersionAvailable,
-		fotmobClient:           fotmob.NewClient(),
+		fotmobClient:           fotmobClient,
 		parser:                 fotmob.NewLiveUpdateParser(),
 		redditClient:           redditClient,
 		goalLinks:              make(map[reddit.GoalLinkKey]*reddit.GoalLink),
-		notifier:               notify.NewDesktopNotifier(),
+		notifier:               newNotifier(debugMode, hookFailedAt),
+		hookFailedAt:           hookFailedAt,
+		followedTeams:          data.FollowedTeamIDs(),
+		watcher:                watcher.New(watcherSource),
 		spinner:                s,
 		randomSpinner:          randomSpinner,
 		statsViewSpinner:       statsViewSpinner,
@@ -216,9 +285,95 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	}
 }
 
+// hookFailureBannerDuration is how long the status bar reports a failed command hook.
+const hookFailureBannerDuration = 5 * time.Minute
+
+// newNotifier creates the notification router for the saved rules, notifying on the desktop
+// and the configured webhooks and command hooks. Those that can't be set up are skipped
+// (and logged in debug mode). Command hook failures are logged in debug mode and stamped
+// in hookFailedAt, for the status bar.
+func newNotifier(debugMode bool, hookFailedAt *atomic.Int64) *notify.Router {
+	var logf func(message string)
+	if debugMode {
+		logf = appendDebugLog
+	}
+	hookLogf := func(message string) {
+		hookFailedAt.Store(time.Now().UnixNano())
+		if logf != nil {
+			logf(message)
+		}
+	}
+
+	channels := map[string]notify.EventNotifier{
+		notify.ChannelDesktop: notify.NewDesktopNotifier(),
+	}
+	settings, err := data.LoadSettings()
+	if err == nil {
+		for _, target := range settings.Webhooks {
+			if _, taken := channels[target.Name]; taken {
+				continue
+			}
+			webhook, err := notify.NewWebhookNotifier(target, logf)
+			if err != nil {
+				if logf != nil {
+					logf(err.Error())
+				}
+				continue
+			}
+			channels[target.Name] = webhook
+		}
+		for _, hook := range settings.Hooks {
+			if _, taken := channels[hook.Name]; taken {
+				continue
+			}
+			command, err := notify.NewCommandNotifier(hook, hookLogf)
+			if err != nil {
+				hookLogf(err.Error())
+				continue
+			}
+			channels[hook.Name] = command
+		}
+	}
+
+	router := notify.NewRouter(channels)
+	if err == nil {
+		if err := router.Configure(settings); err != nil && logf != nil {
+			logf(err.Error())
+		}
+	}
+	return router
+}
+
+// notifierCloseTimeout bounds how long quitting waits for queued webhook posts and hook runs.
+const notifierCloseTimeout = 3 * time.Second
+
+// Close sends the notifications still queued for webhooks and command hooks, waiting
+// notifierCloseTimeout at most. Call it with the final model once the program has exited.
+func Close(final tea.Model) {
+	if m, ok := final.(model); ok && m.notifier != nil {
+		m.notifier.Close(notifierCloseTimeout)
+	}
+}
+
 // getStatusBannerType returns the appropriate status banner type based on current model state.
-// Priority: Debug > Dev > New Version > None
+// Priority: Snoozed > Quiet Hours > Hook failed > Debug > Dev > New Version > None
 func (m model) getStatusBannerType() constants.StatusBannerType {
+	if m.notifier != nil {
+		if until, matchID := m.notifier.Snoozed(); !until.IsZero() {
+			if matchID != 0 {
+				return constants.StatusBannerSnoozedMatch
+			}
+			return constants.StatusBannerSnoozed
+		}
+		if m.notifier.InQuietHours() {
+			return constants.StatusBannerQuietHours
+		}
+	}
+	if m.hookFailedAt != nil {
+		if at := m.hookFailedAt.Load(); at != 0 && time.Since(time.Unix(0, at)) < hookFailureBannerDuration {
+			return constants.StatusBannerHookFailed
+		}
+	}
 	if m.debugMode {
 		return constants.StatusBannerDebug
 	}
@@ -239,11 +394,22 @@ func (m model) getScrollableContentLength() int {
 
 	lineCount := 0
 
+	// A filtered timeline replaces the goals and cards sections
+	if m.timelineFilter.Active() {
+		matching := 0
+		for _, event := range m.matchDetails.Events {
+			if m.timelineFilter.MatchesEvent(event) {
+				matching++
+			}
+		}
+		lineCount += 1 + max(matching, 1) // Section header + events (or the empty message)
+	}
+
 	// Count goals (each goal is typically 1 line + section header)
-	if len(m.matchDetails.Events) > 0 {
+	if len(m.matchDetails.Events) > 0 && !m.timelineFilter.Active() {
 		goalCount := 0
 		for _, event := range m.matchDetails.Events {
-			if event.Type == "goal" {
+			if event.Kind.IsGoal() {
 				goalCount++
 			}
 		}
@@ -252,11 +418,16 @@ func (m model) getScrollableContentLength() int {
 		}
 	}
 
+	// Count penalty shootout (header, two summary rows, blank line, one line per kick)
+	if len(m.matchDetails.Shootout) > 0 {
+		lineCount += 4 + len(m.matchDetails.Shootout)
+	}
+
 	// Count cards (each card is typically 1 line + section header)
-	if len(m.matchDetails.Events) > 0 {
+	if len(m.matchDetails.Events) > 0 && !m.timelineFilter.Active() {
 		cardCount := 0
 		for _, event := range m.matchDetails.Events {
-			if event.Type == "card" {
+			if event.Kind.IsCard() {
 				cardCount++
 			}
 		}
@@ -270,6 +441,9 @@ func (m model) getScrollableContentLength() int {
 		lineCount += 1 + len(m.matchDetails.Statistics) // Section header + stats
 	}
 
+	// Count lineups and unavailable players
+	lineCount += ui.LineupLineCount(m.matchDetails)
+
 	// Add spacing between sections
 	if lineCount > 0 {
 		lineCount += 1 // Extra spacing
@@ -294,11 +468,18 @@ func (m model) getHeaderContentHeight() int {
 	if m.matchDetails.Attendance > 0 {
 		height++
 	}
+	filter := m.timelineFilter
+	filter.Focused = m.statsRightPanelFocused
+	if filter.Shown() {
+		height += 2 // Blank line + filter bar
+	}
 
 	return height
 }
 
 // Init initializes the application.
 func (m model) Init() tea.Cmd {
-	return tea.Batch(m.spinner.Tick, ui.SpinnerTick())
+	// The watcher runs for the whole session, whichever view is open
+	m.watcher.Start(context.Background())
+	return tea.Batch(m.spinner.Tick, ui.SpinnerTick(), scheduleClockTick(), waitForWatcherUpdate(m.watcher))
 }
@@ -4,14 +4,19 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/notify"
 	"github.com/0xjuanma/golazo/internal/reddit"
 	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/0xjuanma/golazo/internal/watcher"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
@@ -34,6 +39,15 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case matchDetailsMsg:
 		return m.handleMatchDetails(msg)
 
+	case commentaryMsg:
+		return m.handleCommentary(msg)
+
+	case teamSearchMsg:
+		if m.settingsState != nil {
+			m.settingsState.SetTeamResults(msg.query, msg.results)
+		}
+		return m, nil
+
 	case tea.KeyMsg:
 		return m.handleKeyPress(msg)
 
@@ -46,6 +60,24 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case liveBatchDataMsg:
 		return m.handleLiveBatchData(msg)
 
+	case matchPreviewMsg:
+		return m.handleMatchPreview(msg)
+
+	case teamDetailsMsg:
+		return m.handleTeamDetails(msg)
+
+	case standingsMsg:
+		return m.handleStandings(msg)
+
+	case standingsLiveMsg:
+		return m.handleStandingsLive(msg)
+
+	case tieMatchMsg:
+		return m.handleTieMatch(msg)
+
+	case playerDetailsMsg:
+		return m.handlePlayerDetails(msg)
+
 	case statsDataMsg:
 		return m.handleStatsData(msg)
 
@@ -58,12 +90,19 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case mainViewCheckMsg:
 		return m.handleMainViewCheck(msg)
 
-	case pollTickMsg:
-		return m.handlePollTick(msg)
+	case liveWatchMsg:
+		return m.handleLiveWatch(msg)
 
 	case pollDisplayCompleteMsg:
 		return m.handlePollDisplayComplete()
 
+	case watcherUpdateMsg:
+		return m.handleWatcherUpdate(msg)
+
+	case clockTickMsg:
+		// Nothing to update: the view reads the clocks from the time of rendering
+		return m, scheduleClockTick()
+
 	case list.FilterMatchesMsg:
 		// Route filter matches message to the appropriate list based on current view
 		return m.handleFilterMatches(msg)
@@ -119,7 +158,7 @@ func (m model) handleWindowSize(msg tea.WindowSizeMsg) (tea.Model, tea.Cmd) {
 			if listHeight < 5 {
 				listHeight = 5
 			}
-			m.settingsState.List.SetSize(48, listHeight)
+			m.settingsState.List.SetSize(54, listHeight)
 		}
 	}
 
@@ -138,17 +177,7 @@ func (m model) handleSpinnerTick(msg spinner.TickMsg) (tea.Model, tea.Cmd) {
 
 // handleLiveUpdate processes live match update messages.
 func (m model) handleLiveUpdate(msg liveUpdateMsg) (tea.Model, tea.Cmd) {
-	if msg.update != "" {
-		m.liveUpdates = append(m.liveUpdates, msg.update)
-	}
-
-	// Continue polling if match is live
-	if m.polling && m.matchDetails != nil && m.matchDetails.Status == api.MatchStatusLive {
-		return m, schedulePollTick(m.matchDetails.ID)
-	}
-
-	m.loading = false
-	m.polling = false
+	m.liveUpdates = append(m.liveUpdates, msg.entries...)
 	return m, nil
 }
 
@@ -192,7 +221,7 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 	// Check if match has goals and fetch links immediately (main branch approach)
 	hasGoals := false
 	for _, event := range msg.details.Events {
-		if event.Type == "goal" {
+		if event.Kind.IsGoal() {
 			hasGoals = true
 			break
 		}
@@ -201,46 +230,27 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, fetchGoalLinks(m.redditClient, msg.details))
 	}
 
-	// Cache for stats view (including during preload)
-	if m.currentView == viewStats || m.pendingSelection == 0 {
+	// Cache for stats view (including during preload, or with the team view on top)
+	if m.currentView == viewStats || m.pendingSelection == 0 || m.baseView() == viewStats {
 		m.matchDetailsCache[msg.details.ID] = msg.details
 		m.loading = false
 		m.statsViewLoading = false
 		return m, tea.Batch(cmds...)
 	}
 
-	// Handle live matches view (including during preload)
-	if m.currentView == viewLiveMatches || m.pendingSelection == 1 {
+	// Handle live matches view (including during preload, or with the team view on top)
+	if m.inLiveView() || m.pendingSelection == 1 {
 		m.liveViewLoading = false
 
-		// Get current scores
-		homeScore := 0
-		awayScore := 0
-		if msg.details.HomeScore != nil {
-			homeScore = *msg.details.HomeScore
-		}
-		if msg.details.AwayScore != nil {
-			awayScore = *msg.details.AwayScore
-		}
-
-		// Detect new goals during poll refresh (not initial load)
-		// Only notify when: polling is active AND we have previous score data
-		hasScoreData := m.lastHomeScore > 0 || m.lastAwayScore > 0 || len(m.lastEvents) > 0
-		if m.polling && hasScoreData {
-			m.notifyNewGoals(msg.details)
-		}
-
-		// Update tracked scores for next comparison
-		m.lastHomeScore = homeScore
-		m.lastAwayScore = awayScore
-
 		// Parse ALL events to rebuild the live updates list
 		// This ensures proper ordering (descending by minute) and uniqueness
-		m.liveUpdates = m.parser.ParseEvents(msg.details.Events, msg.details.HomeTeam, msg.details.AwayTeam)
-		m.lastEvents = msg.details.Events
+		m.liveUpdates = m.parser.ParseEventsWithDisallowed(msg.details.Events, m.disallowedGoals, msg.details.HomeTeam, msg.details.AwayTeam)
 
-		// Continue polling if match is live
-		if msg.details.Status == api.MatchStatusLive {
+		// Refresh the commentary feed with every load and poll
+		cmds = append(cmds, fetchCommentary(m.fotmobClient, msg.details.ID, msg.details.HomeTeam.Name, msg.details.AwayTeam.Name, m.useMockData))
+
+		// The watch keeps polling while the match is in play (including half-time and suspensions)
+		if msg.details.State.InPlay() {
 			// For initial load, clear loading state
 			// For poll refresh, loading is cleared by 1s timer (pollDisplayCompleteMsg)
 			if !m.polling {
@@ -249,8 +259,6 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 			// Note: if m.polling is true, m.loading stays true until the 1s timer fires
 
 			m.polling = true
-			// Schedule next poll tick (90 seconds from now)
-			cmds = append(cmds, schedulePollTick(msg.details.ID))
 		} else {
 			m.loading = false
 			m.polling = false
@@ -265,8 +273,45 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleCommentary merges a commentary fetch into the feed of the selected match.
+// Entries not seen before are counted so the view can highlight what the poll brought in.
+func (m model) handleCommentary(msg commentaryMsg) (tea.Model, tea.Cmd) {
+	if msg.entries == nil || m.matchDetails == nil || m.matchDetails.ID != msg.matchID {
+		return m, nil
+	}
+
+	seen := make(map[string]bool, len(m.commentary))
+	for _, entry := range m.commentary {
+		seen[entry.Key()] = true
+	}
+	newCount := 0
+	for _, entry := range msg.entries {
+		if !seen[entry.Key()] {
+			newCount++
+		}
+	}
+
+	// The newest line is always highlighted, plus anything else a poll brought in
+	highlighted := min(len(msg.entries), 1)
+	if len(m.commentary) > 0 {
+		highlighted = max(highlighted, newCount)
+	}
+	m.commentary = msg.entries
+	m.commentaryNew = highlighted
+	return m, nil
+}
+
 // handleKeyPress routes key events to view-specific handlers.
 func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// A timeline search or jump-to-minute field takes every key until Enter/Esc
+	if m.timelineFilter.Input != ui.TimelineInputNone && (m.currentView == viewLiveMatches || m.currentView == viewStats) {
+		return m.handleTimelineInput(msg)
+	}
+	// So does the settings team search
+	if m.currentView == viewSettings && m.settingsState != nil && m.settingsState.Search.Focused() {
+		return m.handleTeamSearchInput(msg)
+	}
+
 	switch msg.String() {
 	case "q", "ctrl+c":
 		return m, tea.Quit
@@ -293,6 +338,35 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			break
 		}
 
+		// Player profile goes back to the picker, then to the view it was opened from
+		if m.currentView == viewPlayer {
+			if !m.playerPicking && len(m.playerCandidates) > 1 {
+				m.playerPicking = true
+				m.playerDetails = nil
+				m.playerViewLoading = false
+				return m, nil
+			}
+			m.currentView = m.playerReturnView
+			m.playerDetails = nil
+			return m, nil
+		}
+
+		// Tie view returns to the bracket
+		if m.currentView == viewTie {
+			m.currentView = viewStandings
+			m.tie = nil
+			m.tieDetails = nil
+			m.tieLoading = false
+			return m, nil
+		}
+
+		// Team view returns to the view it was opened from
+		if m.currentView == viewTeam {
+			m.currentView = m.teamReturnView
+			m.teamDetails = nil
+			return m, nil
+		}
+
 		if m.currentView != viewMain {
 			return m.resetToMainView()
 		}
@@ -308,6 +382,14 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleStatsSelection(msg)
 	case viewSettings:
 		return m.handleSettingsViewKeys(msg)
+	case viewTeam:
+		return m.handleTeamViewKeys(msg)
+	case viewPlayer:
+		return m.handlePlayerViewKeys(msg)
+	case viewStandings:
+		return m.handleStandingsViewKeys(msg)
+	case viewTie:
+		return m.handleTieViewKeys(msg)
 	}
 
 	return m, nil
@@ -320,18 +402,72 @@ func (m model) resetToMainView() (tea.Model, tea.Cmd) {
 	m.matchDetails = nil
 	m.matchDetailsCache = make(map[int]*api.MatchDetails)
 	m.liveUpdates = nil
-	m.lastEvents = nil
-	m.lastHomeScore = 0
-	m.lastAwayScore = 0
+	m.disallowedGoals = nil
 	m.loading = false
-	m.polling = false
+	m.stopLiveWatch()
 	m.matches = nil
 	m.upcomingMatches = nil
+	m.liveUpcomingFocused = false
+	m.liveDetailsFocused = false
+	m.previewDetails = nil
+	m.stopPreviewLoading()
 	return m, nil
 }
 
 // handleLiveMatchesSelection handles list navigation in live matches view.
+// Tab moves focus between the live list and the upcoming matches below it.
 func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.liveMatchesList.FilterState() != list.Filtering {
+		if msg.String() == "t" || msg.String() == "p" {
+			details := m.matchDetails
+			if m.liveUpcomingFocused {
+				details = m.previewDetails
+			}
+			if msg.String() == "p" {
+				return m.openPlayerPicker(m.matchPlayerCandidates(details))
+			}
+			return m.openTeamView(details)
+		}
+		// 'c' switches the feed between the updates timeline and text commentary
+		if msg.String() == "c" && !m.liveUpcomingFocused {
+			m.showCommentary = !m.showCommentary
+			return m, nil
+		}
+		// 'm' shows only the followed teams' matches, or everything again
+		if msg.String() == "m" {
+			return m.toggleMyTeams()
+		}
+		// 'z' snoozes notifications, or snoozes them until the open match ends
+		if msg.String() == "z" {
+			return m.cycleSnooze()
+		}
+		// Tab cycles focus: live list -> details (timeline filters) -> upcoming list -> live list
+		if msg.String() == "tab" {
+			switch {
+			case !m.liveDetailsFocused && !m.liveUpcomingFocused && m.matchDetails != nil:
+				m.liveDetailsFocused = true
+			case !m.liveUpcomingFocused && len(m.visibleUpcoming()) > 0:
+				m.liveDetailsFocused = false
+				m.liveUpcomingFocused = true
+				m.liveUpcomingSelected = min(m.liveUpcomingSelected, len(m.visibleUpcoming())-1)
+				return m.loadMatchPreview()
+			default:
+				m.liveDetailsFocused = false
+				m.liveUpcomingFocused = false
+				m.stopPreviewLoading()
+			}
+			return m, nil
+		}
+		if m.liveUpcomingFocused {
+			return m.handleUpcomingKeys(msg)
+		}
+		if m.liveDetailsFocused {
+			// List navigation is paused while the details panel has focus
+			updated, _ := m.handleTimelineKeys(msg)
+			return updated, nil
+		}
+	}
+
 	// Capture selected item BEFORE Update (critical for filter mode - selection changes after filter clears)
 	var preUpdateMatchID int
 	if preItem := m.liveMatchesList.SelectedItem(); preItem != nil {
@@ -425,11 +561,24 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			// Tab toggles focus back to left panel
 			m.statsRightPanelFocused = false
 			return m, nil
+		default:
+			if updated, ok := m.handleTimelineKeys(msg); ok {
+				return updated, nil
+			}
 		}
 	}
 
 	// Only handle date range navigation when NOT filtering
 	if !isFiltering {
+		if msg.String() == "t" {
+			return m.openTeamView(m.matchDetails)
+		}
+		if msg.String() == "p" {
+			return m.openPlayerPicker(m.matchPlayerCandidates(m.matchDetails))
+		}
+		if msg.String() == "m" {
+			return m.toggleMyTeams()
+		}
 		if msg.String() == "h" || msg.String() == "left" || msg.String() == "l" || msg.String() == "right" {
 			return m.handleStatsViewKeys(msg)
 		}
@@ -490,31 +639,20 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 func (m model) handleLiveMatches(msg liveMatchesMsg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
-	// Schedule the next refresh (5-min timer)
-	cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData))
-
 	if len(msg.matches) == 0 {
 		m.liveViewLoading = false
 		m.loading = false
-		return m, tea.Batch(cmds...)
+		return m, m.startLiveRefresh()
 	}
 
-	// Convert to display format
-	displayMatches := make([]ui.MatchDisplay, 0, len(msg.matches))
-	for _, match := range msg.matches {
-		displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
-	}
-
-	m.matches = displayMatches
+	// Update list
+	m.setLiveMatches(msg.matches)
 	m.selected = 0
 	m.loading = false
-	cmds = append(cmds, ui.SpinnerTick())
-
-	// Update list
-	m.liveMatchesList.SetItems(ui.ToMatchListItems(displayMatches))
+	cmds = append(cmds, ui.SpinnerTick(), m.startLiveRefresh())
 	m.updateLiveListSize()
 
-	if len(displayMatches) > 0 {
+	if len(m.matches) > 0 {
 		m.liveMatchesList.Select(0)
 		updatedModel, loadCmd := m.loadMatchDetails(m.matches[0].ID)
 		if updatedM, ok := updatedModel.(model); ok {
@@ -528,30 +666,18 @@ func (m model) handleLiveMatches(msg liveMatchesMsg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
-// handleLiveRefresh processes periodic live matches refresh (every 5 min).
-// Only updates if still in the live view.
+// handleLiveRefresh processes the scheduled live matches refresh.
+// Only updates if still in the live view and the refresh chain is current.
 func (m model) handleLiveRefresh(msg liveRefreshMsg) (tea.Model, tea.Cmd) {
-	// Ignore refresh if not in live view (user navigated away)
-	if m.currentView != viewLiveMatches {
+	// Ignore refresh if not in live view (user navigated away) or replaced by a newer chain
+	if !m.inLiveView() || msg.gen != m.liveRefreshGen {
 		return m, nil
 	}
 
-	var cmds []tea.Cmd
-
-	// Schedule the next refresh
-	cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData))
-
 	if len(msg.matches) == 0 {
 		// No live matches - clear list but keep view
-		m.matches = nil
-		m.liveMatchesList.SetItems(nil)
-		return m, tea.Batch(cmds...)
-	}
-
-	// Convert to display format
-	displayMatches := make([]ui.MatchDisplay, 0, len(msg.matches))
-	for _, match := range msg.matches {
-		displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
+		m.setLiveMatches(nil)
+		return m, m.nextLiveRefresh()
 	}
 
 	// Preserve current selection if possible
@@ -560,22 +686,91 @@ func (m model) handleLiveRefresh(msg liveRefreshMsg) (tea.Model, tea.Cmd) {
 		currentMatchID = m.matches[m.selected].ID
 	}
 
-	m.matches = displayMatches
-	m.liveMatchesList.SetItems(ui.ToMatchListItems(displayMatches))
+	m.setLiveMatches(msg.matches)
 	m.updateLiveListSize()
+	m.selectLiveMatch(currentMatchID)
+
+	// Matches that kicked off move from the upcoming section to the list
+	m.dropStartedUpcoming()
+
+	return m, m.nextLiveRefresh()
+}
 
-	// Try to restore previous selection
+// setLiveMatches shows live matches in the live list, followed teams first.
+// The matches are kept as they came for when the My teams filter changes.
+func (m *model) setLiveMatches(matches []api.Match) {
+	m.liveMatchesAll = matches
+	m.matches = m.followedFirst(matches)
+	m.liveMatchesList.SetItems(ui.ToMatchListItems(m.matches))
+}
+
+// selectLiveMatch selects the match in the live list, or the first match if it's no longer listed.
+func (m *model) selectLiveMatch(matchID int) {
 	newSelected := 0
-	for i, match := range displayMatches {
-		if match.ID == currentMatchID {
+	for i, match := range m.matches {
+		if match.ID == matchID {
 			newSelected = i
 			break
 		}
 	}
 	m.selected = newSelected
 	m.liveMatchesList.Select(newSelected)
+}
 
-	return m, tea.Batch(cmds...)
+// followedFirst prepares matches for a list: followed teams' matches are marked and pinned
+// to the top, and with the My teams filter on, the other matches are left out.
+func (m model) followedFirst(matches []api.Match) []ui.MatchDisplay {
+	displayMatches := make([]ui.MatchDisplay, 0, len(matches))
+	for _, match := range matches {
+		displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
+	}
+	return ui.PinFollowed(displayMatches, m.followedTeams, m.myTeamsOnly)
+}
+
+// visibleUpcoming returns the live view's upcoming matches as listed: followed teams first,
+// and only theirs with the My teams filter on. liveUpcomingSelected indexes this list.
+func (m model) visibleUpcoming() []ui.MatchDisplay {
+	return ui.PinFollowed(m.liveUpcomingMatches, m.followedTeams, m.myTeamsOnly)
+}
+
+// startLiveRefresh starts a new live list refresh chain, replacing any running one.
+func (m *model) startLiveRefresh() tea.Cmd {
+	m.liveRefreshGen++
+	return m.nextLiveRefresh()
+}
+
+// nextLiveRefresh schedules the chain's next live list refresh: at the next kickoff,
+// sooner around half-time and full-time, and rarely when nothing is on.
+func (m model) nextLiveRefresh() tea.Cmd {
+	live := make([]api.Match, 0, len(m.matches))
+	for _, match := range m.matches {
+		live = append(live, match.Match)
+	}
+	upcoming := make([]api.Match, 0, len(m.liveUpcomingMatches))
+	for _, match := range m.liveUpcomingMatches {
+		upcoming = append(upcoming, match.Match)
+	}
+
+	delay := watcher.NextRefresh(time.Now(), live, upcoming)
+	m.debugLog(fmt.Sprintf("Next live refresh in %s (%d live, %d upcoming)", delay, len(live), len(upcoming)))
+	return scheduleLiveRefresh(m.fotmobClient, m.useMockData, m.liveRefreshGen, delay)
+}
+
+// dropStartedUpcoming removes upcoming matches that are now in the live list.
+func (m *model) dropStartedUpcoming() {
+	live := make(map[int]bool, len(m.matches))
+	for _, match := range m.matches {
+		live[match.ID] = true
+	}
+
+	upcoming := m.liveUpcomingMatches[:0]
+	for _, match := range m.liveUpcomingMatches {
+		if !live[match.ID] {
+			upcoming = append(upcoming, match)
+		}
+	}
+	m.liveUpcomingMatches = upcoming
+	m.liveUpcomingSelected = max(min(m.liveUpcomingSelected, len(m.visibleUpcoming())-1), 0)
 }
 
 // handleLiveBatchData processes parallel batch loading - multiple leagues at once.
@@ -588,17 +783,17 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 		m.liveMatchesBuffer = append(m.liveMatchesBuffer, msg.matches...)
 	}
 
+	// Merge today's upcoming matches into the bottom section of the left panel
+	if len(msg.upcoming) > 0 {
+		m.mergeLiveUpcoming(msg.upcoming)
+	}
+
 	// Track progress
 	m.liveBatchesLoaded++
 
 	// Update UI immediately with current data
 	if len(m.liveMatchesBuffer) > 0 {
-		displayMatches := make([]ui.MatchDisplay, 0, len(m.liveMatchesBuffer))
-		for _, match := range m.liveMatchesBuffer {
-			displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
-		}
-		m.matches = displayMatches
-		m.liveMatchesList.SetItems(ui.ToMatchListItems(displayMatches))
+		m.setLiveMatches(m.liveMatchesBuffer)
 		m.updateLiveListSize()
 
 		// On first batch with matches, select first match and load details
@@ -624,8 +819,8 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 			m.fotmobClient.Cache().SetLiveMatches(m.liveMatchesBuffer)
 		}
 
-		// Schedule periodic refresh
-		cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData))
+		// Schedule the kickoff-aware refresh
+		cmds = append(cmds, m.startLiveRefresh())
 
 		return m, tea.Batch(cmds...)
 	}
@@ -640,6 +835,149 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// mergeLiveUpcoming adds upcoming matches to the live view, deduplicated by match ID
+// and sorted by kickoff time.
+func (m *model) mergeLiveUpcoming(upcoming []api.Match) {
+	existingIDs := make(map[int]bool)
+	for _, match := range m.liveUpcomingMatches {
+		existingIDs[match.ID] = true
+	}
+
+	for _, match := range upcoming {
+		if !existingIDs[match.ID] {
+			m.liveUpcomingMatches = append(m.liveUpcomingMatches, ui.MatchDisplay{Match: match})
+			existingIDs[match.ID] = true
+		}
+	}
+
+	sort.SliceStable(m.liveUpcomingMatches, func(i, j int) bool {
+		a, b := m.liveUpcomingMatches[i].MatchTime, m.liveUpcomingMatches[j].MatchTime
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return a.Before(*b)
+	})
+}
+
+// handleMatchPreview processes details for the selected upcoming match.
+// Stale responses (user moved on to another match) are ignored, though the
+// spinner started for them is cleared.
+func (m model) handleMatchPreview(msg matchPreviewMsg) (tea.Model, tea.Cmd) {
+	if msg.table != nil {
+		m.previewTables[msg.leagueID] = msg.table
+	}
+	if msg.matchID == m.previewLoadingID {
+		m.stopPreviewLoading()
+	}
+
+	if m.currentView != viewLiveMatches || !m.liveUpcomingFocused {
+		return m, nil
+	}
+	upcoming := m.visibleUpcoming()
+	if m.liveUpcomingSelected >= len(upcoming) || upcoming[m.liveUpcomingSelected].ID != msg.matchID {
+		return m, nil
+	}
+
+	m.previewDetails = msg.details
+	return m, nil
+}
+
+// baseView returns the list view underneath any team or player views opened on top of it.
+func (m model) baseView() view {
+	v := m.currentView
+	if v == viewPlayer {
+		v = m.playerReturnView
+	}
+	if v == viewTeam {
+		v = m.teamReturnView
+	}
+	return v
+}
+
+// inLiveView reports whether the live view is active, either directly or underneath
+// team/player views opened from it. Polling and refreshes keep running in both cases.
+func (m model) inLiveView() bool {
+	return m.baseView() == viewLiveMatches
+}
+
+// handleTeamDetails processes a team overview response.
+// Stale responses (user already switched teams or left the view) are ignored.
+func (m model) handleTeamDetails(msg teamDetailsMsg) (tea.Model, tea.Cmd) {
+	if m.currentView != viewTeam {
+		return m, nil
+	}
+
+	expected := m.teamMatchTeams[0]
+	if m.teamShowingAway {
+		expected = m.teamMatchTeams[1]
+	}
+	if msg.teamID != expected {
+		return m, nil
+	}
+
+	m.teamViewLoading = false
+	m.teamDetails = msg.details
+	return m, nil
+}
+
+// handleStandings stores a league's standings. The table map records the league as
+// fetched even when empty, so failed leagues aren't refetched on every tab switch.
+func (m model) handleStandings(msg standingsMsg) (tea.Model, tea.Cmd) {
+	if m.standingsLeagueIdx < len(m.standingsLeagues) && m.standingsLeagues[m.standingsLeagueIdx] == msg.leagueID {
+		m.standingsLoading = false
+	}
+
+	// Leave the league unloaded so selecting it again retries
+	if msg.err != nil {
+		m.standingsErrors[msg.leagueID] = msg.err
+		return m, nil
+	}
+	delete(m.standingsErrors, msg.leagueID)
+
+	m.standingsTables[msg.leagueID] = msg.table
+	m.standingsLeaders[msg.leagueID] = msg.leaders
+	m.standingsBrackets[msg.leagueID] = msg.bracket
+	m.standingsLive[msg.leagueID] = msg.live
+	return m, nil
+}
+
+// handleStandingsLive stores a league's live matches, refetched when its tab is shown again.
+// A successful refresh replaces the list, so finished matches drop out of the projection;
+// a failed one keeps the previous scores. In between, watcher updates keep the list current.
+func (m model) handleStandingsLive(msg standingsLiveMsg) (tea.Model, tea.Cmd) {
+	if msg.gen != m.standingsPollGen {
+		return m, nil
+	}
+	if msg.ok {
+		m.standingsLive[msg.leagueID] = msg.live
+	}
+	return m, nil
+}
+
+// handleTieMatch processes details for a bracket leg. Responses for a leg that is
+// no longer selected are ignored.
+func (m model) handleTieMatch(msg tieMatchMsg) (tea.Model, tea.Cmd) {
+	if m.currentView != viewTie || m.tie == nil || m.tieLeg >= len(m.tie.Legs) || m.tie.Legs[m.tieLeg].ID != msg.matchID {
+		return m, nil
+	}
+
+	m.tieLoading = false
+	m.tieDetails = msg.details
+	return m, nil
+}
+
+// handlePlayerDetails processes a player profile response.
+// Responses arriving after the user went back to the picker or left the view are ignored.
+func (m model) handlePlayerDetails(msg playerDetailsMsg) (tea.Model, tea.Cmd) {
+	if m.currentView != viewPlayer || m.playerPicking || !m.playerViewLoading {
+		return m, nil
+	}
+
+	m.playerViewLoading = false
+	m.playerDetails = msg.details
+	return m, nil
+}
+
 // updateLiveListSize sets the live list dimensions based on window size.
 func (m *model) updateLiveListSize() {
 	const spinnerHeight = 3
@@ -828,13 +1166,9 @@ func (m *model) applyStatsDateFilter() {
 		finishedMatches = m.statsData.AllFinished
 	}
 
-	// Convert to display format
-	displayMatches := make([]ui.MatchDisplay, 0, len(finishedMatches))
-	for _, match := range finishedMatches {
-		displayMatches = append(displayMatches, ui.MatchDisplay{Match: match})
-	}
-	m.matches = displayMatches
-	m.statsMatchesList.SetItems(ui.ToMatchListItems(displayMatches))
+	// Convert to display format, followed teams first
+	m.matches = m.followedFirst(finishedMatches)
+	m.statsMatchesList.SetItems(ui.ToMatchListItems(m.matches))
 	// Note: Upcoming matches are now shown in the Live view instead
 }
 
@@ -867,7 +1201,7 @@ func filterMatchesByDays(matches []api.Match, days int) []api.Match {
 // Uses a SINGLE tick chain - all spinners share the same tick rate.
 func (m model) handleRandomSpinnerTick(msg ui.TickMsg) (tea.Model, tea.Cmd) {
 	// Check if any spinner needs to be animated
-	needsTick := m.mainViewLoading || m.liveViewLoading || m.statsViewLoading || m.polling
+	needsTick := m.mainViewLoading || m.liveViewLoading || m.statsViewLoading || m.teamViewLoading || m.playerViewLoading || m.standingsLoading || m.tieLoading || m.polling
 
 	if !needsTick {
 		// No spinners active - don't continue the tick chain
@@ -887,6 +1221,11 @@ func (m model) handleRandomSpinnerTick(msg ui.TickMsg) (tea.Model, tea.Cmd) {
 		m.statsViewSpinner.Tick()
 	}
 
+	if (m.teamViewLoading && m.currentView == viewTeam) || (m.playerViewLoading && m.currentView == viewPlayer) ||
+		(m.standingsLoading && m.currentView == viewStandings) || (m.tieLoading && m.currentView == viewTie) {
+		m.randomSpinner.Tick()
+	}
+
 	// Update polling spinner when polling is active
 	if m.polling && m.pollingSpinner != nil {
 		m.pollingSpinner.Tick()
@@ -955,29 +1294,34 @@ func (m model) handleMainViewCheck(msg mainViewCheckMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-// handlePollTick handles the 90-second poll tick.
-// Shows "Updating..." spinner for 1s as visual feedback, then fetches data.
-func (m model) handlePollTick(msg pollTickMsg) (tea.Model, tea.Cmd) {
-	// Only process if we're still in live view and polling is active
-	if m.currentView != viewLiveMatches || !m.polling {
+// handleLiveWatch applies an update from the selected match's stream.
+// Updates after the initial load flash the "Updating..." spinner for 1s.
+func (m model) handleLiveWatch(msg liveWatchMsg) (tea.Model, tea.Cmd) {
+	// Drop updates from the watch of a match no longer selected
+	if msg.gen != m.liveWatchGen || m.liveWatch == nil {
 		return m, nil
 	}
+	wait := waitForLiveWatch(m.liveWatch, m.liveWatchGen)
 
-	// Verify the poll is for the currently selected match
-	if m.matchDetails == nil || m.matchDetails.ID != msg.matchID {
-		return m, nil
+	if msg.update.Err != nil {
+		m.debugLog(fmt.Sprintf("handleLiveWatch: %v", msg.update.Err))
+		// Keep showing the last poll; only a failed initial load clears the view
+		if !m.liveViewLoading {
+			return m, wait
+		}
+		updated, cmd := m.handleMatchDetails(matchDetailsMsg{details: nil})
+		return updated, tea.Batch(cmd, wait)
 	}
 
-	// Set loading state to show "Updating..." spinner
-	m.loading = true
-
-	// Start the actual API call, spinner animation, and 1s display timer
-	// Also check for any new goals that might have been scored since last poll
-	return m, tea.Batch(
-		fetchPollMatchDetails(m.fotmobClient, msg.matchID, m.useMockData),
-		ui.SpinnerTick(),
-		schedulePollSpinnerHide(), // Hide spinner after 0.5 seconds
-	)
+	var cmds []tea.Cmd
+	if !msg.update.Initial {
+		m.loading = true
+		cmds = append(cmds, ui.SpinnerTick(), schedulePollSpinnerHide())
+	}
+	m.trackDisallowedGoals(msg.update.Events.RuledOut, msg.update.Details)
+	updated, cmd := m.handleMatchDetails(matchDetailsMsg{details: msg.update.Details})
+	cmds = append(cmds, cmd, wait)
+	return updated, tea.Batch(cmds...)
 }
 
 // handlePollDisplayComplete hides the spinner after 1s display time.
@@ -1013,53 +1357,130 @@ func (m model) handleFilterMatches(msg list.FilterMatchesMsg) (tea.Model, tea.Cm
 	return m, cmd
 }
 
-// notifyNewGoals sends desktop notifications when a goal is scored.
-// Uses score-based detection (more reliable than event ID comparison).
-// Only called during poll refreshes when we have previous score data.
-func (m *model) notifyNewGoals(details *api.MatchDetails) {
-	if m.notifier == nil || details == nil {
+// trackDisallowedGoals records the goals the stream found ruled out (removed, or turned into
+// another event) since the last poll, so the live timeline keeps them struck through.
+// Goals that come back (a VAR decision reversed) are dropped again.
+func (m *model) trackDisallowedGoals(ruledOut []api.MatchEvent, details *api.MatchDetails) {
+	if details == nil {
 		return
 	}
 
-	// Get current scores
-	homeScore := 0
-	awayScore := 0
-	if details.HomeScore != nil {
-		homeScore = *details.HomeScore
+	// Drop earlier disallowed goals that are back as goals
+	reinstated := make(map[int]bool)
+	for _, event := range details.Events {
+		if event.Kind.IsGoal() {
+			reinstated[event.ID] = true
+		}
 	}
-	if details.AwayScore != nil {
-		awayScore = *details.AwayScore
+	var disallowed []api.MatchEvent
+	for _, event := range m.disallowedGoals {
+		if !reinstated[event.ID] {
+			disallowed = append(disallowed, event)
+		}
 	}
+	m.disallowedGoals = append(disallowed, ruledOut...)
+}
 
-	// Check if score increased (goal scored)
-	homeGoalScored := homeScore > m.lastHomeScore
-	awayGoalScored := awayScore > m.lastAwayScore
+// handleWatcherUpdate announces what the background watcher found in a live match
+// and refreshes the match wherever it is listed, whichever view is open.
+func (m model) handleWatcherUpdate(msg watcherUpdateMsg) (tea.Model, tea.Cmd) {
+	m.notifyWatchedMatch(msg.update)
+	if msg.update.Details != nil {
+		m.applyWatchedMatch(msg.update.Details.Match)
+	}
+	return m, waitForWatcherUpdate(m.watcher)
+}
 
-	if !homeGoalScored && !awayGoalScored {
+// notifyWatchedMatch sends what a watcher update has to announce to the channels
+// chosen by the notification rules. Events of a kind found in the same poll
+// (e.g., two quick goals) go out as one.
+func (m *model) notifyWatchedMatch(update watcher.Update) {
+	if m.notifier == nil {
 		return
 	}
 
-	// Find the most recent goal event to get player details
-	var goalEvent *api.MatchEvent
-	for i := len(details.Events) - 1; i >= 0; i-- {
-		event := details.Events[i]
-		if strings.ToLower(event.Type) == "goal" {
-			// Check if this goal matches the team that scored
-			if homeGoalScored && event.Team.ID == details.HomeTeam.ID {
-				goalEvent = &event
-				break
+	for _, event := range notify.Coalesce(update.NotifyEvents()) {
+		// Errors are silently ignored to not disrupt the app
+		sent, _ := m.notifier.Notify(event)
+
+		switch event.Kind {
+		case notify.KindVAR:
+			// 'p' should no longer offer a scorer whose goal didn't count
+			if m.lastNotifiedScorer != nil && event.Event.PlayerID != 0 && event.Event.PlayerID == m.lastNotifiedScorer.ID {
+				m.lastNotifiedScorer = nil
 			}
-			if awayGoalScored && event.Team.ID == details.AwayTeam.ID {
-				goalEvent = &event
-				break
+		case notify.KindGoal:
+			// Remember the scorer so 'p' offers their profile first
+			goal := event.Event
+			if sent && goal.Player != nil && goal.PlayerID != 0 {
+				m.lastNotifiedScorer = &ui.PlayerCandidate{
+					ID:     goal.PlayerID,
+					Name:   *goal.Player,
+					Team:   goal.Team.ShortName,
+					Detail: "Last goal notification",
+				}
+			}
+		}
+	}
+}
+
+// applyWatchedMatch copies a watched match's score and state into the lists showing it:
+// the live matches list and the projected standings' live matches.
+func (m *model) applyWatchedMatch(match api.Match) {
+	m.trackStandingsLive(match)
+
+	listed := false
+	for i := range m.matches {
+		if m.matches[i].ID == match.ID {
+			refreshScore(&m.matches[i].Match, match)
+			listed = true
+		}
+	}
+	if listed && m.inLiveView() {
+		m.liveMatchesList.SetItems(ui.ToMatchListItems(m.matches))
+	}
+	for i := range m.liveMatchesAll {
+		if m.liveMatchesAll[i].ID == match.ID {
+			refreshScore(&m.liveMatchesAll[i], match)
+		}
+	}
+
+	for _, live := range m.standingsLive {
+		for i := range live {
+			if live[i].ID == match.ID {
+				refreshScore(&live[i], match)
 			}
 		}
 	}
+}
 
-	if goalEvent != nil {
-		// Send notification - errors are silently ignored to not disrupt the app
-		_ = m.notifier.Goal(*goalEvent, details.HomeTeam, details.AwayTeam, homeScore, awayScore)
+// trackStandingsLive keeps the projected tables' live matches in step with the watcher,
+// for leagues whose table is loaded: a match in play joins its league's list, and one no
+// longer in play (finished, abandoned...) leaves it. Scores are refreshed by applyWatchedMatch.
+func (m *model) trackStandingsLive(match api.Match) {
+	leagueID := match.League.ID
+	if _, loaded := m.standingsTables[leagueID]; !loaded {
+		return
 	}
+
+	live := m.standingsLive[leagueID]
+	i := slices.IndexFunc(live, func(listed api.Match) bool { return listed.ID == match.ID })
+	switch {
+	case match.State.InPlay() && i < 0:
+		m.standingsLive[leagueID] = append(live, match)
+	case !match.State.InPlay() && i >= 0:
+		m.standingsLive[leagueID] = slices.Delete(live, i, i+1)
+	}
+}
+
+// refreshScore copies the score and state of a polled match into a listed one.
+// Other fields are kept, since list and details payloads name leagues and teams differently.
+func refreshScore(listed *api.Match, polled api.Match) {
+	listed.HomeScore = polled.HomeScore
+	listed.AwayScore = polled.AwayScore
+	listed.State = polled.State
+	listed.Status = polled.Status
+	listed.PeriodStart = polled.PeriodStart
 }
 
 // max returns the larger of two integers.
@@ -1110,6 +1531,17 @@ func (m model) debugLog(message string) {
 	if !m.debugMode {
 		return // Silently skip if debug mode is not enabled
 	}
+	appendDebugLog(message)
+}
+
+// debugLogMu serializes debug log writes and rotation: notifier workers log from their own goroutines.
+var debugLogMu sync.Mutex
+
+// appendDebugLog writes a line to the debug log, rotating it as needed, for the model
+// and for components logging outside it. Callers check debug mode.
+func appendDebugLog(message string) {
+	debugLogMu.Lock()
+	defer debugLogMu.Unlock()
 
 	configDir, err := data.ConfigDir()
 	if err != nil {
@@ -1118,8 +1550,8 @@ func (m model) debugLog(message string) {
 
 	logFile := filepath.Join(configDir, "golazo_debug.log")
 
-	// Check file size and rotate if necessary
-	if err := m.rotateDebugLogIfNeeded(logFile); err != nil {
+	// Check file size and rotate if necessary (rotation doesn't use the model's state)
+	if err := (model{}).rotateDebugLogIfNeeded(logFile); err != nil {
 		return // Silently fail if rotation fails
 	}
 
@@ -1,6 +1,10 @@
 package app
 
 import (
+	"fmt"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/reddit"
 	"github.com/0xjuanma/golazo/internal/ui"
 )
@@ -13,11 +17,29 @@ func (m model) View() string {
 
 	case viewLiveMatches:
 		m.ensureLiveListSize()
+
+		// The right panel shows the preview while the upcoming list has focus
+		details := m.matchDetails
+		upcomingSelected := -1
+		var previewTable []api.LeagueTableEntry
+		if m.liveUpcomingFocused {
+			details = m.previewDetails
+			upcomingSelected = m.liveUpcomingSelected
+			if details != nil {
+				previewTable = m.previewTables[details.League.ID]
+			}
+		}
+
 		return ui.RenderMultiPanelViewWithList(
 			m.width, m.height,
 			m.liveMatchesList,
-			m.matchDetails,
-			m.liveUpdates,
+			details,
+			ui.LiveFeed{
+				Updates:        m.liveUpdates,
+				Commentary:     m.commentary,
+				NewCommentary:  m.commentaryNew,
+				ShowCommentary: m.showCommentary,
+			},
 			m.spinner,
 			m.loading,
 			m.randomSpinner,
@@ -26,9 +48,12 @@ func (m model) View() string {
 			m.liveTotalBatches,
 			m.pollingSpinner,
 			m.polling,
-			m.liveUpcomingMatches,
+			m.visibleUpcoming(),
+			upcomingSelected,
+			previewTable,
 			m.buildGoalLinksMap(),
 			m.getStatusBannerType(),
+			m.timelineFilterFor(m.liveDetailsFocused),
 		)
 
 	case viewStats:
@@ -48,11 +73,38 @@ func (m model) View() string {
 			&m.statsDetailsViewport,
 			m.statsRightPanelFocused,
 			m.statsScrollOffset,
+			m.timelineFilterFor(m.statsRightPanelFocused),
 		)
 
 	case viewSettings:
 		return ui.RenderSettingsView(m.width, m.height, m.settingsState, m.getStatusBannerType())
 
+	case viewTeam:
+		return ui.RenderTeamView(m.width, m.height, m.teamDetails, m.randomSpinner, m.teamViewLoading, m.teamScrollOffset, m.getStatusBannerType())
+
+	case viewPlayer:
+		return ui.RenderPlayerView(m.width, m.height, m.playerCandidates, m.playerPickerSelected, m.playerPicking, m.playerDetails, m.randomSpinner, m.playerViewLoading, m.getStatusBannerType())
+
+	case viewStandings:
+		leagueNames := make([]string, 0, len(m.standingsLeagues))
+		for _, id := range m.standingsLeagues {
+			name := fmt.Sprintf("League %d", id)
+			if info, ok := data.LeagueByID(id); ok {
+				name = info.Name
+			}
+			leagueNames = append(leagueNames, name)
+		}
+		var leagueID int
+		if m.standingsLeagueIdx < len(m.standingsLeagues) {
+			leagueID = m.standingsLeagues[m.standingsLeagueIdx]
+		}
+		tables, movement, liveTeams := m.projectedStandings(leagueID)
+		return ui.RenderStandingsView(m.width, m.height, leagueNames, m.standingsLeagueIdx, m.standingsTab,
+			tables, movement, liveTeams, m.standingsLeaders[leagueID], m.standingsBrackets[leagueID], m.standingsErrors[leagueID], m.randomSpinner, m.standingsLoading, m.standingsScroll, m.getStatusBannerType())
+
+	case viewTie:
+		return ui.RenderTieView(m.width, m.height, m.tie, m.tieStage, m.tieLeg, m.tieDetails, m.spinner, m.randomSpinner, m.tieLoading, m.getStatusBannerType())
+
 	default:
 		return ui.RenderMainMenu(m.width, m.height, m.selected, m.spinner, m.randomSpinner, m.mainViewLoading, m.getStatusBannerType())
 	}
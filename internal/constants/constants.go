@@ -18,4 +18,12 @@ const (
 	StatusBannerNewVersion
 	// StatusBannerDev indicates this is a development build.
 	StatusBannerDev
+	// StatusBannerSnoozed indicates notifications are snoozed for a while.
+	StatusBannerSnoozed
+	// StatusBannerSnoozedMatch indicates notifications are snoozed until a match ends.
+	StatusBannerSnoozedMatch
+	// StatusBannerQuietHours indicates notifications are muted by quiet hours.
+	StatusBannerQuietHours
+	// StatusBannerHookFailed indicates a command hook failed in the last few minutes.
+	StatusBannerHookFailed
 )
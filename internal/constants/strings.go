@@ -4,6 +4,7 @@ package constants
 const (
 	MenuStats       = "Finished Matches"
 	MenuLiveMatches = "Live Matches"
+	MenuStandings   = "Standings"
 	MenuSettings    = "Settings"
 )
 
@@ -14,23 +15,46 @@ const (
 	PanelMinuteByMinute  = "Minute-by-minute"
 	PanelMatchStatistics = "Match Statistics"
 	PanelUpdates         = "Updates"
+	PanelCommentary      = "Commentary"
+	PanelSquad           = "Squad"
+	PanelSelectPlayer    = "Select Player"
+	PanelCareer          = "Career"
 )
 
 // Empty state messages
 const (
-	EmptyNoLiveMatches     = "No live matches"
-	EmptyNoFinishedMatches = "No finished matches"
-	EmptySelectMatch       = "Select a match"
-	EmptyNoUpdates         = "No updates"
-	EmptyNoMatches         = "No matches available"
+	EmptyNoLiveMatches        = "No live matches"
+	EmptyNoFinishedMatches    = "No finished matches"
+	EmptySelectMatch          = "Select a match"
+	EmptyNoUpdates            = "No updates"
+	EmptyNoMatchingEvents     = "No events match the filter"
+	EmptyNoCommentary         = "No commentary for this match"
+	EmptyNoMatches            = "No matches available"
+	EmptyTeamUnavailable      = "Team details unavailable"
+	EmptyPlayerUnavailable    = "Player details unavailable"
+	EmptyNoLeagues            = "No leagues selected"
+	EmptyStandingsUnavailable = "Standings unavailable"
+	EmptyMatchUnavailable     = "Match details unavailable"
+)
+
+// Error messages
+const (
+	ErrorStandingsFetch = "Couldn't load standings: %v\nSwitch league and back to retry"
 )
 
 // Help text
 const (
-	HelpMainMenu     = "↑/↓: navigate  Enter: select  q: quit"
-	HelpMatchesView  = "↑/↓: navigate  /: filter  Esc: back  q: quit"
-	HelpSettingsView = "↑/↓: navigate  Space: toggle  /: filter  Enter: save  Esc: back"
-	HelpStatsView    = "h/l: date range  j/k: navigate  Tab: focus details  ↑/↓: scroll when focused  /: filter  Esc: back"
+	HelpMainMenu      = "↑/↓: navigate  Enter: select  z: snooze alerts  q: quit"
+	HelpMatchesView   = "↑/↓: navigate  Tab: details/upcoming  c: commentary  m: my teams  z: snooze  /: filter  Esc: back  q: quit"
+	HelpSettingsView  = "↑/↓: navigate  Space: toggle  /: filter  Enter: save  Esc: back"
+	HelpSettingsTeams = "↑/↓: navigate  Space: follow  /: search  Enter: save  Esc: back"
+	HelpSettingsRules = "Space: on/off  0-9: events  w: when  c: channel  a: add  x: delete  Enter: save  Esc: back"
+	HelpStatsView     = "h/l: date range  j/k: navigate  Tab: focus details  ↑/↓: scroll when focused  m: my teams  /: filter  Esc: back"
+	HelpTeamView      = "t: other team  p: players  ↑/↓: scroll squad  Esc: back"
+	HelpPlayerPicker  = "↑/↓: navigate  Enter: open profile  Esc: back"
+	HelpPlayerView    = "Esc: back"
+	HelpStandingsView = "h/l: league  Tab: table/leaders/bracket  ↑/↓: scroll or select tie  Enter: open tie  Esc: back"
+	HelpTieView       = "h/l: leg  t: teams  p: players  Esc: back"
 )
 
 // Status text
@@ -51,6 +75,24 @@ const (
 const (
 	// NotificationTitleGoal is the title shown in goal notifications.
 	NotificationTitleGoal = "⚽ GOLAZO!"
+	// NotificationTitleGoalDisallowed is the title shown when a notified goal is ruled out.
+	NotificationTitleGoalDisallowed = "🚫 Goal disallowed"
+	// NotificationTitleRedCard is the title shown when a player is sent off.
+	NotificationTitleRedCard = "🟥 Red card"
+	// NotificationTitleKickoff is the title shown when a match kicks off.
+	NotificationTitleKickoff = "⏱ Kickoff"
+	// NotificationTitleHalfTime is the title shown at half-time.
+	NotificationTitleHalfTime = "⏸ Half-time"
+	// NotificationTitleFullTime is the title shown when a match ends.
+	NotificationTitleFullTime = "🏁 Full-time"
+	// NotificationTitlePenaltyAwarded is the title shown when a penalty is given.
+	NotificationTitlePenaltyAwarded = "❗ Penalty"
+	// NotificationTitlePenaltyMissed is the title shown when a penalty is missed or saved.
+	NotificationTitlePenaltyMissed = "❌ Penalty missed"
+	// NotificationTitleLineups is the title shown when confirmed lineups are out.
+	NotificationTitleLineups = "📋 Lineups are out"
+	// NotificationTitlePostponed is the title shown when a match is postponed.
+	NotificationTitlePostponed = "📅 Postponed"
 )
 
 // Stats labels
@@ -0,0 +1,34 @@
+package data
+
+import "github.com/0xjuanma/golazo/internal/api"
+
+// MockCommentary returns mock live text commentary for a match, newest first.
+// Matches without mock commentary return an empty slice.
+func MockCommentary(matchID int) []api.CommentaryEntry {
+	switch matchID {
+	case 2001: // Chelsea 2-1 Spurs (67')
+		return []api.CommentaryEntry{
+			{ID: "2001-11", Minute: 67, Text: "Spurs push forward in numbers, but Colwill reads the cross and heads clear."},
+			{ID: "2001-10", Minute: 65, Text: "Corner to Tottenham. Maddison whips it in, punched away by Sanchez."},
+			{ID: "2001-9", Minute: 62, Kind: api.EventYellowCard, Important: true, Text: "Caicedo (Chelsea) is shown the yellow card for a late challenge on Bissouma."},
+			{ID: "2001-8", Minute: 56, Kind: api.EventGoal, Important: true, Text: "Goal! Chelsea 2, Tottenham 1. Nicolas Jackson (Chelsea) right footed shot from the centre of the box. Assisted by Cole Palmer."},
+			{ID: "2001-7", Minute: 51, Text: "Attempt saved. Palmer (Chelsea) left footed shot from outside the box is saved in the bottom right corner."},
+			{ID: "2001-6", Minute: 46, Text: "Second half begins Chelsea 1, Tottenham 1."},
+			{ID: "2001-5", Minute: 45, AddedTime: 2, Text: "First half ends, Chelsea 1, Tottenham 1."},
+			{ID: "2001-4", Minute: 45, Kind: api.EventSubstitution, Text: "Substitution, Chelsea. Mykhailo Mudryk replaces Noni Madueke."},
+			{ID: "2001-3", Minute: 34, Kind: api.EventGoal, Important: true, Text: "Goal! Chelsea 1, Tottenham 1. Son Heung-Min (Tottenham) left footed shot from the left side of the box. Assisted by James Maddison."},
+			{ID: "2001-2", Minute: 23, Kind: api.EventYellowCard, Important: true, Text: "Cristian Romero (Tottenham) is shown the yellow card for a bad foul."},
+			{ID: "2001-1", Minute: 12, Kind: api.EventGoal, Important: true, Text: "Goal! Chelsea 1, Tottenham 0. Cole Palmer (Chelsea) left footed shot from outside the box to the top left corner."},
+			{ID: "2001-0", Text: "Lineups are announced and players are warming up."},
+		}
+	case 2002: // Real Madrid 1-1 Atletico (34')
+		return []api.CommentaryEntry{
+			{ID: "2002-4", Minute: 34, Text: "Vinicius Jr skips past Molina on the left and wins a corner."},
+			{ID: "2002-3", Minute: 28, Kind: api.EventGoal, Important: true, Text: "Goal! Real Madrid 1, Atletico Madrid 1. Jude Bellingham (Real Madrid) header from the centre of the box. Assisted by Vinicius Jr."},
+			{ID: "2002-2", Minute: 18, Kind: api.EventYellowCard, Important: true, Text: "Stefan Savic (Atletico Madrid) is shown the yellow card."},
+			{ID: "2002-1", Minute: 8, Kind: api.EventGoal, Important: true, Text: "Goal! Real Madrid 0, Atletico Madrid 1. Antoine Griezmann (Atletico Madrid) right footed shot from the centre of the box."},
+		}
+	default:
+		return []api.CommentaryEntry{}
+	}
+}
@@ -13,7 +13,7 @@ func MockMatchDetails(matchID int) (*api.MatchDetails, error) {
 	liveMatches := MockLiveMatches()
 	for i := range liveMatches {
 		if liveMatches[i].ID == matchID {
-			events := generateLiveMatchEvents(matchID, liveMatches[i])
+			events := assignMockPlayerIDs(generateLiveMatchEvents(matchID, liveMatches[i]))
 			stats := generateMockStatistics(matchID)
 			return &api.MatchDetails{
 				Match:      liveMatches[i],
@@ -30,7 +30,7 @@ func MockMatchDetails(matchID int) (*api.MatchDetails, error) {
 	finishedMatches := MockFinishedMatches()
 	for i := range finishedMatches {
 		if finishedMatches[i].ID == matchID {
-			events := generateFinishedMatchEvents(matchID, finishedMatches[i])
+			events := assignMockPlayerIDs(generateFinishedMatchEvents(matchID, finishedMatches[i]))
 			stats := generateMockStatistics(matchID)
 			return &api.MatchDetails{
 				Match:      finishedMatches[i],
@@ -57,30 +57,30 @@ func generateLiveMatchEvents(matchID int, match api.Match) []api.MatchEvent {
 
 	case 2001: // Chelsea 2-1 Spurs (67') - Premier League
 		events = []api.MatchEvent{
-			{ID: 1, Minute: 12, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Palmer"), Timestamp: time.Now()},
-			{ID: 2, Minute: 23, Type: "card", Team: match.AwayTeam, Player: stringPtr("Romero"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 3, Minute: 34, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Son"), Assist: stringPtr("Maddison"), Timestamp: time.Now()},
-			{ID: 4, Minute: 45, Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Mudryk"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 5, Minute: 56, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Jackson"), Assist: stringPtr("Palmer"), Timestamp: time.Now()},
-			{ID: 6, Minute: 62, Type: "card", Team: match.HomeTeam, Player: stringPtr("Caicedo"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
+			{ID: 1, Minute: 12, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Palmer"), Timestamp: time.Now()},
+			{ID: 2, Minute: 23, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Romero"), Timestamp: time.Now()},
+			{ID: 3, Minute: 34, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Son"), Assist: stringPtr("Maddison"), Timestamp: time.Now()},
+			{ID: 4, Minute: 45, Kind: api.EventSubstitution, Team: match.HomeTeam, PlayerIn: stringPtr("Mudryk"), Timestamp: time.Now()},
+			{ID: 5, Minute: 56, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Jackson"), Assist: stringPtr("Palmer"), Timestamp: time.Now()},
+			{ID: 6, Minute: 62, Kind: api.EventYellowCard, Team: match.HomeTeam, Player: stringPtr("Caicedo"), Timestamp: time.Now()},
 		}
 
 	case 2002: // Real Madrid 1-1 Atletico (34') - La Liga
 		events = []api.MatchEvent{
-			{ID: 7, Minute: 8, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Griezmann"), Timestamp: time.Now()},
-			{ID: 8, Minute: 18, Type: "card", Team: match.AwayTeam, Player: stringPtr("Savic"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 9, Minute: 28, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Bellingham"), Assist: stringPtr("Vinicius Jr"), Timestamp: time.Now()},
+			{ID: 7, Minute: 8, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Griezmann"), Timestamp: time.Now()},
+			{ID: 8, Minute: 18, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Savic"), Timestamp: time.Now()},
+			{ID: 9, Minute: 28, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Bellingham"), Assist: stringPtr("Vinicius Jr"), Timestamp: time.Now()},
 		}
 
 	case 2003: // Man City 3-2 Bayern (56') - Champions League
 		events = []api.MatchEvent{
-			{ID: 10, Minute: 5, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Haaland"), Timestamp: time.Now()},
-			{ID: 11, Minute: 15, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Kane"), Assist: stringPtr("Sane"), Timestamp: time.Now()},
-			{ID: 12, Minute: 23, Type: "card", Team: match.HomeTeam, Player: stringPtr("Rodri"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 13, Minute: 34, Type: "goal", Team: match.HomeTeam, Player: stringPtr("De Bruyne"), Timestamp: time.Now()},
-			{ID: 14, Minute: 42, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Musiala"), Timestamp: time.Now()},
-			{ID: 15, Minute: 45, Type: "substitution", Team: match.AwayTeam, Player: stringPtr("Coman"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 16, Minute: 52, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Foden"), Assist: stringPtr("Haaland"), Timestamp: time.Now()},
+			{ID: 10, Minute: 5, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Haaland"), Timestamp: time.Now()},
+			{ID: 11, Minute: 15, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Kane"), Assist: stringPtr("Sane"), Timestamp: time.Now()},
+			{ID: 12, Minute: 23, Kind: api.EventYellowCard, Team: match.HomeTeam, Player: stringPtr("Rodri"), Timestamp: time.Now()},
+			{ID: 13, Minute: 34, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("De Bruyne"), Timestamp: time.Now()},
+			{ID: 14, Minute: 42, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Musiala"), Timestamp: time.Now()},
+			{ID: 15, Minute: 45, Kind: api.EventSubstitution, Team: match.AwayTeam, PlayerIn: stringPtr("Coman"), Timestamp: time.Now()},
+			{ID: 16, Minute: 52, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Foden"), Assist: stringPtr("Haaland"), Timestamp: time.Now()},
 		}
 
 	// ═══════════════════════════════════════════════
@@ -89,28 +89,28 @@ func generateLiveMatchEvents(matchID int, match api.Match) []api.MatchEvent {
 
 	case 2004: // Arsenal 2-3 Liverpool (FT) - Premier League
 		events = []api.MatchEvent{
-			{ID: 17, Minute: 8, DisplayMinute: "8'", Type: "goal", Team: match.AwayTeam, Player: stringPtr("Salah"), Timestamp: time.Now()},
-			{ID: 18, Minute: 15, DisplayMinute: "15'", Type: "card", Team: match.HomeTeam, Player: stringPtr("Rice"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 19, Minute: 23, DisplayMinute: "23'", Type: "goal", Team: match.HomeTeam, Player: stringPtr("Saka"), Assist: stringPtr("Odegaard"), Timestamp: time.Now()},
-			{ID: 20, Minute: 34, DisplayMinute: "34'", Type: "substitution", Team: match.AwayTeam, Player: stringPtr("Gakpo"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 21, Minute: 45, DisplayMinute: "45+1'", Type: "goal", Team: match.AwayTeam, Player: stringPtr("Nunez"), Timestamp: time.Now()},
-			{ID: 22, Minute: 56, DisplayMinute: "56'", Type: "card", Team: match.AwayTeam, Player: stringPtr("Van Dijk"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 23, Minute: 67, DisplayMinute: "67'", Type: "goal", Team: match.HomeTeam, Player: stringPtr("Martinelli"), Timestamp: time.Now()},
-			{ID: 24, Minute: 78, DisplayMinute: "78'", Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Trossard"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 25, Minute: 85, DisplayMinute: "85'", Type: "card", Team: match.HomeTeam, Player: stringPtr("Gabriel"), EventType: stringPtr("red"), Timestamp: time.Now()},
-			{ID: 26, Minute: 90, DisplayMinute: "90+3'", Type: "goal", Team: match.AwayTeam, Player: stringPtr("Diaz"), Assist: stringPtr("Salah"), Timestamp: time.Now()},
+			{ID: 17, Minute: 8, DisplayMinute: "8'", Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Salah"), Timestamp: time.Now()},
+			{ID: 18, Minute: 15, DisplayMinute: "15'", Kind: api.EventYellowCard, Team: match.HomeTeam, Player: stringPtr("Rice"), Timestamp: time.Now()},
+			{ID: 19, Minute: 23, DisplayMinute: "23'", Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Saka"), Assist: stringPtr("Odegaard"), Timestamp: time.Now()},
+			{ID: 20, Minute: 34, DisplayMinute: "34'", Kind: api.EventSubstitution, Team: match.AwayTeam, PlayerIn: stringPtr("Gakpo"), Timestamp: time.Now()},
+			{ID: 21, Minute: 45, DisplayMinute: "45+1'", Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Nunez"), Timestamp: time.Now()},
+			{ID: 22, Minute: 56, DisplayMinute: "56'", Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Van Dijk"), Timestamp: time.Now()},
+			{ID: 23, Minute: 67, DisplayMinute: "67'", Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Martinelli"), Timestamp: time.Now()},
+			{ID: 24, Minute: 78, DisplayMinute: "78'", Kind: api.EventSubstitution, Team: match.HomeTeam, PlayerIn: stringPtr("Trossard"), Timestamp: time.Now()},
+			{ID: 25, Minute: 85, DisplayMinute: "85'", Kind: api.EventRedCard, Team: match.HomeTeam, Player: stringPtr("Gabriel"), Timestamp: time.Now()},
+			{ID: 26, Minute: 90, DisplayMinute: "90+3'", Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Diaz"), Assist: stringPtr("Salah"), Timestamp: time.Now()},
 		}
 
 	case 2005: // Barcelona 4-1 Sevilla (FT) - La Liga
 		events = []api.MatchEvent{
-			{ID: 27, Minute: 12, DisplayMinute: "12'", Type: "goal", Team: match.HomeTeam, Player: stringPtr("Lewandowski"), Timestamp: time.Now()},
-			{ID: 28, Minute: 23, DisplayMinute: "23'", Type: "goal", Team: match.HomeTeam, Player: stringPtr("Yamal"), Assist: stringPtr("Pedri"), Timestamp: time.Now()},
-			{ID: 29, Minute: 34, DisplayMinute: "34'", Type: "card", Team: match.AwayTeam, Player: stringPtr("Gudelj"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 30, Minute: 45, DisplayMinute: "45+2'", Type: "goal", Team: match.AwayTeam, Player: stringPtr("Lukebakio"), Timestamp: time.Now()},
-			{ID: 31, Minute: 56, DisplayMinute: "56'", Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Ferran Torres"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 32, Minute: 67, DisplayMinute: "67'", Type: "goal", Team: match.HomeTeam, Player: stringPtr("Raphinha"), Timestamp: time.Now()},
-			{ID: 33, Minute: 78, DisplayMinute: "78'", Type: "card", Team: match.HomeTeam, Player: stringPtr("Araujo"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 34, Minute: 90, DisplayMinute: "90+1'", Type: "goal", Team: match.HomeTeam, Player: stringPtr("Lewandowski"), Assist: stringPtr("Yamal"), Timestamp: time.Now()},
+			{ID: 27, Minute: 12, DisplayMinute: "12'", Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Lewandowski"), Timestamp: time.Now()},
+			{ID: 28, Minute: 23, DisplayMinute: "23'", Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Yamal"), Assist: stringPtr("Pedri"), Timestamp: time.Now()},
+			{ID: 29, Minute: 34, DisplayMinute: "34'", Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Gudelj"), Timestamp: time.Now()},
+			{ID: 30, Minute: 45, DisplayMinute: "45+2'", Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Lukebakio"), Timestamp: time.Now()},
+			{ID: 31, Minute: 56, DisplayMinute: "56'", Kind: api.EventSubstitution, Team: match.HomeTeam, PlayerIn: stringPtr("Ferran Torres"), Timestamp: time.Now()},
+			{ID: 32, Minute: 67, DisplayMinute: "67'", Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Raphinha"), Timestamp: time.Now()},
+			{ID: 33, Minute: 78, DisplayMinute: "78'", Kind: api.EventYellowCard, Team: match.HomeTeam, Player: stringPtr("Araujo"), Timestamp: time.Now()},
+			{ID: 34, Minute: 90, DisplayMinute: "90+1'", Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Lewandowski"), Assist: stringPtr("Yamal"), Timestamp: time.Now()},
 		}
 	}
 
@@ -169,6 +169,7 @@ func getMockVenue(matchID int) string {
 		1004: "Civitas Metropolitano",
 		1005: "Parc des Princes",
 		1006: "San Siro",
+		1007: "Allianz Arena",
 		1010: "St. James' Park",
 		1011: "Mestalla",
 		1012: "Diego Armando Maradona",
@@ -192,6 +193,7 @@ func getMockReferee(matchID int) string {
 		1004: "Carlos del Cerro Grande",
 		1005: "Clement Turpin",
 		1006: "Felix Brych",
+		1007: "Szymon Marciniak",
 		1010: "Simon Hooper",
 		1011: "Alejandro Hernandez",
 		1012: "Gianluca Rocchi",
@@ -215,6 +217,7 @@ func getMockAttendance(matchID int) int {
 		1004: 68456,
 		1005: 48583,
 		1006: 75923,
+		1007: 75024,
 		1010: 52305,
 		1011: 43850,
 		1012: 54726,
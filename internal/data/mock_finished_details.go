@@ -25,17 +25,86 @@ func MockFinishedMatchDetails(matchID int) (*api.MatchDetails, error) {
 	}
 
 	// Generate events and stats
-	events := generateFinishedMatchEvents(matchID, *match)
+	events := assignMockPlayerIDs(generateFinishedMatchEvents(matchID, *match))
 	stats := generateMockStatistics(matchID)
 
-	return &api.MatchDetails{
+	details := &api.MatchDetails{
 		Match:      *match,
 		Events:     events,
 		Statistics: stats,
 		Venue:      getMockVenue(matchID),
 		Referee:    getMockReferee(matchID),
 		Attendance: getMockAttendance(matchID),
-	}, nil
+	}
+
+	// Knockout matches decided on penalties
+	if shootout := generateMockShootout(matchID, *match); len(shootout) > 0 {
+		last := shootout[len(shootout)-1]
+		details.Shootout = shootout
+		details.ExtraTime = true
+		details.MatchDuration = 120
+		details.Penalties = &struct {
+			Home *int `json:"home,omitempty"`
+			Away *int `json:"away,omitempty"`
+		}{Home: intPtr(last.HomeScore), Away: intPtr(last.AwayScore)}
+		winner := "home"
+		if last.AwayScore > last.HomeScore {
+			winner = "away"
+		}
+		details.Winner = &winner
+	}
+
+	return details, nil
+}
+
+// generateMockShootout returns the penalty shootout kicks for matches decided on penalties.
+func generateMockShootout(matchID int, match api.Match) []api.PenaltyKick {
+	type kick struct {
+		home    bool
+		player  string
+		outcome api.PenaltyOutcome
+	}
+
+	var kicks []kick
+	switch matchID {
+	case 1007: // Bayern 0-1 PSG, Bayern win 4-3 on penalties
+		kicks = []kick{
+			{true, "Kane", api.PenaltyScored},
+			{false, "Vitinha", api.PenaltyScored},
+			{true, "Musiala", api.PenaltyScored},
+			{false, "Dembele", api.PenaltySaved},
+			{true, "Kimmich", api.PenaltyMissed},
+			{false, "Hakimi", api.PenaltyScored},
+			{true, "Sane", api.PenaltyScored},
+			{false, "Kolo Muani", api.PenaltyScored},
+			{true, "Upamecano", api.PenaltyScored},
+			{false, "Marquinhos", api.PenaltySaved},
+		}
+	}
+
+	shootout := make([]api.PenaltyKick, 0, len(kicks))
+	homeScore, awayScore := 0, 0
+	for _, k := range kicks {
+		team := match.AwayTeam
+		if k.home {
+			team = match.HomeTeam
+		}
+		if k.outcome == api.PenaltyScored {
+			if k.home {
+				homeScore++
+			} else {
+				awayScore++
+			}
+		}
+		shootout = append(shootout, api.PenaltyKick{
+			Team:      team,
+			Player:    k.player,
+			Outcome:   k.outcome,
+			HomeScore: homeScore,
+			AwayScore: awayScore,
+		})
+	}
+	return shootout
 }
 
 // generateFinishedMatchEvents generates comprehensive events for finished matches.
@@ -50,28 +119,28 @@ func generateFinishedMatchEvents(matchID int, match api.Match) []api.MatchEvent
 
 	case 1010: // Newcastle 2-1 Aston Villa
 		events = []api.MatchEvent{
-			{ID: 51, Minute: 18, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Isak"), Timestamp: time.Now()},
-			{ID: 52, Minute: 34, Type: "card", Team: match.AwayTeam, Player: stringPtr("Konsa"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 53, Minute: 56, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Watkins"), Assist: stringPtr("McGinn"), Timestamp: time.Now()},
-			{ID: 54, Minute: 78, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Gordon"), Timestamp: time.Now()},
+			{ID: 51, Minute: 18, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Isak"), Timestamp: time.Now()},
+			{ID: 52, Minute: 34, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Konsa"), Timestamp: time.Now()},
+			{ID: 53, Minute: 56, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Watkins"), Assist: stringPtr("McGinn"), Timestamp: time.Now()},
+			{ID: 54, Minute: 78, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Gordon"), Timestamp: time.Now()},
 		}
 
 	case 1011: // Valencia 0-2 Athletic Bilbao
 		events = []api.MatchEvent{
-			{ID: 55, Minute: 23, Type: "card", Team: match.HomeTeam, Player: stringPtr("Mosquera"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 56, Minute: 45, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Williams"), Timestamp: time.Now()},
-			{ID: 57, Minute: 67, Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Hugo Duro"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 58, Minute: 82, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Sancet"), Assist: stringPtr("Williams"), Timestamp: time.Now()},
+			{ID: 55, Minute: 23, Kind: api.EventYellowCard, Team: match.HomeTeam, Player: stringPtr("Mosquera"), Timestamp: time.Now()},
+			{ID: 56, Minute: 45, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Williams"), Timestamp: time.Now()},
+			{ID: 57, Minute: 67, Kind: api.EventSubstitution, Team: match.HomeTeam, PlayerIn: stringPtr("Hugo Duro"), Timestamp: time.Now()},
+			{ID: 58, Minute: 82, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Sancet"), Assist: stringPtr("Williams"), Timestamp: time.Now()},
 		}
 
 	case 1012: // Napoli 3-1 Roma
 		events = []api.MatchEvent{
-			{ID: 59, Minute: 12, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Osimhen"), Timestamp: time.Now()},
-			{ID: 60, Minute: 28, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Dybala"), Timestamp: time.Now()},
-			{ID: 61, Minute: 45, Type: "card", Team: match.AwayTeam, Player: stringPtr("Cristante"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 62, Minute: 56, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Kvaratskhelia"), Assist: stringPtr("Osimhen"), Timestamp: time.Now()},
-			{ID: 63, Minute: 78, Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Simeone"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 64, Minute: 89, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Politano"), Timestamp: time.Now()},
+			{ID: 59, Minute: 12, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Osimhen"), Timestamp: time.Now()},
+			{ID: 60, Minute: 28, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Dybala"), Timestamp: time.Now()},
+			{ID: 61, Minute: 45, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Cristante"), Timestamp: time.Now()},
+			{ID: 62, Minute: 56, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Kvaratskhelia"), Assist: stringPtr("Osimhen"), Timestamp: time.Now()},
+			{ID: 63, Minute: 78, Kind: api.EventSubstitution, Team: match.HomeTeam, PlayerIn: stringPtr("Simeone"), Timestamp: time.Now()},
+			{ID: 64, Minute: 89, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Politano"), Timestamp: time.Now()},
 		}
 
 	// ═══════════════════════════════════════════════
@@ -80,27 +149,27 @@ func generateFinishedMatchEvents(matchID int, match api.Match) []api.MatchEvent
 
 	case 1001: // Man City 2-1 Arsenal
 		events = []api.MatchEvent{
-			{ID: 1, Minute: 12, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Haaland"), Timestamp: time.Now()},
-			{ID: 2, Minute: 23, Type: "card", Team: match.AwayTeam, Player: stringPtr("Rice"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 3, Minute: 34, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Saka"), Assist: stringPtr("Odegaard"), Timestamp: time.Now()},
-			{ID: 4, Minute: 45, Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Grealish"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 5, Minute: 56, Type: "card", Team: match.HomeTeam, Player: stringPtr("Rodri"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 6, Minute: 67, Type: "goal", Team: match.HomeTeam, Player: stringPtr("De Bruyne"), Assist: stringPtr("Foden"), Timestamp: time.Now()},
-			{ID: 7, Minute: 78, Type: "substitution", Team: match.AwayTeam, Player: stringPtr("Trossard"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 8, Minute: 85, Type: "card", Team: match.AwayTeam, Player: stringPtr("Saliba"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
+			{ID: 1, Minute: 12, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Haaland"), Timestamp: time.Now()},
+			{ID: 2, Minute: 23, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Rice"), Timestamp: time.Now()},
+			{ID: 3, Minute: 34, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Saka"), Assist: stringPtr("Odegaard"), Timestamp: time.Now()},
+			{ID: 4, Minute: 45, Kind: api.EventSubstitution, Team: match.HomeTeam, PlayerIn: stringPtr("Grealish"), Timestamp: time.Now()},
+			{ID: 5, Minute: 56, Kind: api.EventYellowCard, Team: match.HomeTeam, Player: stringPtr("Rodri"), Timestamp: time.Now()},
+			{ID: 6, Minute: 67, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("De Bruyne"), Assist: stringPtr("Foden"), Timestamp: time.Now()},
+			{ID: 7, Minute: 78, Kind: api.EventSubstitution, Team: match.AwayTeam, PlayerIn: stringPtr("Trossard"), Timestamp: time.Now()},
+			{ID: 8, Minute: 85, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Saliba"), Timestamp: time.Now()},
 		}
 
 	case 1002: // Man Utd 0-3 Liverpool
 		events = []api.MatchEvent{
-			{ID: 9, Minute: 5, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Salah"), Timestamp: time.Now()},
-			{ID: 10, Minute: 15, Type: "card", Team: match.HomeTeam, Player: stringPtr("Casemiro"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 11, Minute: 23, Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Garnacho"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 12, Minute: 34, Type: "card", Team: match.AwayTeam, Player: stringPtr("Mac Allister"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 13, Minute: 45, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Nunez"), Assist: stringPtr("Salah"), Timestamp: time.Now()},
-			{ID: 14, Minute: 56, Type: "substitution", Team: match.AwayTeam, Player: stringPtr("Gakpo"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 15, Minute: 67, Type: "card", Team: match.HomeTeam, Player: stringPtr("Martinez"), EventType: stringPtr("red"), Timestamp: time.Now()},
-			{ID: 16, Minute: 78, Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Hojlund"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 17, Minute: 89, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Diaz"), Timestamp: time.Now()},
+			{ID: 9, Minute: 5, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Salah"), Timestamp: time.Now()},
+			{ID: 10, Minute: 15, Kind: api.EventYellowCard, Team: match.HomeTeam, Player: stringPtr("Casemiro"), Timestamp: time.Now()},
+			{ID: 11, Minute: 23, Kind: api.EventSubstitution, Team: match.HomeTeam, PlayerIn: stringPtr("Garnacho"), Timestamp: time.Now()},
+			{ID: 12, Minute: 34, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Mac Allister"), Timestamp: time.Now()},
+			{ID: 13, Minute: 45, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Nunez"), Assist: stringPtr("Salah"), Timestamp: time.Now()},
+			{ID: 14, Minute: 56, Kind: api.EventSubstitution, Team: match.AwayTeam, PlayerIn: stringPtr("Gakpo"), Timestamp: time.Now()},
+			{ID: 15, Minute: 67, Kind: api.EventRedCard, Team: match.HomeTeam, Player: stringPtr("Martinez"), Timestamp: time.Now()},
+			{ID: 16, Minute: 78, Kind: api.EventSubstitution, Team: match.HomeTeam, PlayerIn: stringPtr("Hojlund"), Timestamp: time.Now()},
+			{ID: 17, Minute: 89, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Diaz"), Timestamp: time.Now()},
 		}
 
 	// ═══════════════════════════════════════════════
@@ -109,26 +178,26 @@ func generateFinishedMatchEvents(matchID int, match api.Match) []api.MatchEvent
 
 	case 1003: // Real Madrid 3-2 Barcelona (El Clasico)
 		events = []api.MatchEvent{
-			{ID: 18, Minute: 8, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Lewandowski"), Timestamp: time.Now()},
-			{ID: 19, Minute: 15, Type: "card", Team: match.HomeTeam, Player: stringPtr("Tchouameni"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 20, Minute: 23, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Vinicius Jr"), Timestamp: time.Now()},
-			{ID: 21, Minute: 34, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Bellingham"), Assist: stringPtr("Modric"), Timestamp: time.Now()},
-			{ID: 22, Minute: 45, Type: "substitution", Team: match.AwayTeam, Player: stringPtr("Ferran Torres"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 23, Minute: 52, Type: "card", Team: match.AwayTeam, Player: stringPtr("Gavi"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 24, Minute: 56, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Pedri"), Timestamp: time.Now()},
-			{ID: 25, Minute: 67, Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Camavinga"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 26, Minute: 78, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Rodrygo"), Assist: stringPtr("Vinicius Jr"), Timestamp: time.Now()},
-			{ID: 27, Minute: 85, Type: "card", Team: match.AwayTeam, Player: stringPtr("Araujo"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
+			{ID: 18, Minute: 8, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Lewandowski"), Timestamp: time.Now()},
+			{ID: 19, Minute: 15, Kind: api.EventYellowCard, Team: match.HomeTeam, Player: stringPtr("Tchouameni"), Timestamp: time.Now()},
+			{ID: 20, Minute: 23, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Vinicius Jr"), Timestamp: time.Now()},
+			{ID: 21, Minute: 34, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Bellingham"), Assist: stringPtr("Modric"), Timestamp: time.Now()},
+			{ID: 22, Minute: 45, Kind: api.EventSubstitution, Team: match.AwayTeam, PlayerIn: stringPtr("Ferran Torres"), Timestamp: time.Now()},
+			{ID: 23, Minute: 52, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Gavi"), Timestamp: time.Now()},
+			{ID: 24, Minute: 56, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Pedri"), Timestamp: time.Now()},
+			{ID: 25, Minute: 67, Kind: api.EventSubstitution, Team: match.HomeTeam, PlayerIn: stringPtr("Camavinga"), Timestamp: time.Now()},
+			{ID: 26, Minute: 78, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Rodrygo"), Assist: stringPtr("Vinicius Jr"), Timestamp: time.Now()},
+			{ID: 27, Minute: 85, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Araujo"), Timestamp: time.Now()},
 		}
 
 	case 1004: // Atletico 1-1 Sevilla
 		events = []api.MatchEvent{
-			{ID: 28, Minute: 23, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Griezmann"), Assist: stringPtr("Morata"), Timestamp: time.Now()},
-			{ID: 29, Minute: 34, Type: "card", Team: match.AwayTeam, Player: stringPtr("Gudelj"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 30, Minute: 45, Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Correa"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 31, Minute: 56, Type: "card", Team: match.HomeTeam, Player: stringPtr("Koke"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 32, Minute: 78, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Lukebakio"), Timestamp: time.Now()},
-			{ID: 33, Minute: 89, Type: "card", Team: match.AwayTeam, Player: stringPtr("Acuna"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
+			{ID: 28, Minute: 23, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Griezmann"), Assist: stringPtr("Morata"), Timestamp: time.Now()},
+			{ID: 29, Minute: 34, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Gudelj"), Timestamp: time.Now()},
+			{ID: 30, Minute: 45, Kind: api.EventSubstitution, Team: match.HomeTeam, PlayerIn: stringPtr("Correa"), Timestamp: time.Now()},
+			{ID: 31, Minute: 56, Kind: api.EventYellowCard, Team: match.HomeTeam, Player: stringPtr("Koke"), Timestamp: time.Now()},
+			{ID: 32, Minute: 78, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Lukebakio"), Timestamp: time.Now()},
+			{ID: 33, Minute: 89, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Acuna"), Timestamp: time.Now()},
 		}
 
 	// ═══════════════════════════════════════════════
@@ -137,27 +206,37 @@ func generateFinishedMatchEvents(matchID int, match api.Match) []api.MatchEvent
 
 	case 1005: // PSG 2-3 Bayern
 		events = []api.MatchEvent{
-			{ID: 34, Minute: 8, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Kane"), Timestamp: time.Now()},
-			{ID: 35, Minute: 18, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Mbappe"), Timestamp: time.Now()},
-			{ID: 36, Minute: 28, Type: "card", Team: match.AwayTeam, Player: stringPtr("Upamecano"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 37, Minute: 34, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Musiala"), Assist: stringPtr("Sane"), Timestamp: time.Now()},
-			{ID: 38, Minute: 45, Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Kolo Muani"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 39, Minute: 56, Type: "card", Team: match.HomeTeam, Player: stringPtr("Vitinha"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 40, Minute: 67, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Dembele"), Assist: stringPtr("Mbappe"), Timestamp: time.Now()},
-			{ID: 41, Minute: 78, Type: "substitution", Team: match.AwayTeam, Player: stringPtr("Coman"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 42, Minute: 85, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Kane"), Assist: stringPtr("Muller"), Timestamp: time.Now()},
-			{ID: 43, Minute: 90, Type: "card", Team: match.HomeTeam, Player: stringPtr("Marquinhos"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
+			{ID: 34, Minute: 8, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Kane"), Timestamp: time.Now()},
+			{ID: 35, Minute: 18, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Mbappe"), Timestamp: time.Now()},
+			{ID: 36, Minute: 28, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Upamecano"), Timestamp: time.Now()},
+			{ID: 37, Minute: 34, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Musiala"), Assist: stringPtr("Sane"), Timestamp: time.Now()},
+			{ID: 38, Minute: 45, Kind: api.EventSubstitution, Team: match.HomeTeam, PlayerIn: stringPtr("Kolo Muani"), Timestamp: time.Now()},
+			{ID: 39, Minute: 56, Kind: api.EventYellowCard, Team: match.HomeTeam, Player: stringPtr("Vitinha"), Timestamp: time.Now()},
+			{ID: 40, Minute: 67, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Dembele"), Assist: stringPtr("Mbappe"), Timestamp: time.Now()},
+			{ID: 41, Minute: 78, Kind: api.EventSubstitution, Team: match.AwayTeam, PlayerIn: stringPtr("Coman"), Timestamp: time.Now()},
+			{ID: 42, Minute: 85, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Kane"), Assist: stringPtr("Muller"), Timestamp: time.Now()},
+			{ID: 43, Minute: 90, Kind: api.EventYellowCard, Team: match.HomeTeam, Player: stringPtr("Marquinhos"), Timestamp: time.Now()},
+		}
+
+	case 1007: // Bayern 0-1 PSG (AET, Bayern win on penalties)
+		events = []api.MatchEvent{
+			{ID: 51, Minute: 22, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Hakimi"), Timestamp: time.Now()},
+			{ID: 52, Minute: 58, Kind: api.EventGoal, Team: match.AwayTeam, Player: stringPtr("Dembele"), Assist: stringPtr("Vitinha"), Timestamp: time.Now()},
+			{ID: 53, Minute: 64, Kind: api.EventSubstitution, Team: match.HomeTeam, PlayerIn: stringPtr("Sane"), Timestamp: time.Now()},
+			{ID: 54, Minute: 81, Kind: api.EventYellowCard, Team: match.HomeTeam, Player: stringPtr("Kimmich"), Timestamp: time.Now()},
+			{ID: 55, Minute: 105, Kind: api.EventSubstitution, Team: match.AwayTeam, PlayerIn: stringPtr("Kolo Muani"), Timestamp: time.Now()},
+			{ID: 56, Minute: 118, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Marquinhos"), Timestamp: time.Now()},
 		}
 
 	case 1006: // Inter 1-0 Dortmund
 		events = []api.MatchEvent{
-			{ID: 44, Minute: 15, Type: "card", Team: match.AwayTeam, Player: stringPtr("Hummels"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 45, Minute: 34, Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Thuram"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 46, Minute: 45, Type: "card", Team: match.HomeTeam, Player: stringPtr("Barella"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 47, Minute: 56, Type: "substitution", Team: match.AwayTeam, Player: stringPtr("Malen"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 48, Minute: 67, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Lautaro"), Assist: stringPtr("Calhanoglu"), Timestamp: time.Now()},
-			{ID: 49, Minute: 78, Type: "card", Team: match.AwayTeam, Player: stringPtr("Sabitzer"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 50, Minute: 89, Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Arnautovic"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
+			{ID: 44, Minute: 15, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Hummels"), Timestamp: time.Now()},
+			{ID: 45, Minute: 34, Kind: api.EventSubstitution, Team: match.HomeTeam, PlayerIn: stringPtr("Thuram"), Timestamp: time.Now()},
+			{ID: 46, Minute: 45, Kind: api.EventYellowCard, Team: match.HomeTeam, Player: stringPtr("Barella"), Timestamp: time.Now()},
+			{ID: 47, Minute: 56, Kind: api.EventSubstitution, Team: match.AwayTeam, PlayerIn: stringPtr("Malen"), Timestamp: time.Now()},
+			{ID: 48, Minute: 67, Kind: api.EventGoal, Team: match.HomeTeam, Player: stringPtr("Lautaro"), Assist: stringPtr("Calhanoglu"), Timestamp: time.Now()},
+			{ID: 49, Minute: 78, Kind: api.EventYellowCard, Team: match.AwayTeam, Player: stringPtr("Sabitzer"), Timestamp: time.Now()},
+			{ID: 50, Minute: 89, Kind: api.EventSubstitution, Team: match.HomeTeam, PlayerIn: stringPtr("Arnautovic"), Timestamp: time.Now()},
 		}
 	}
 
@@ -7,7 +7,7 @@ import (
 )
 
 // MockFinishedMatches returns finished matches for the stats view.
-// 9 matches from preferred leagues: Premier League, La Liga, Champions League
+// 10 matches from preferred leagues: Premier League, La Liga, Champions League
 func MockFinishedMatches() []api.Match {
 	now := time.Now()
 	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
@@ -35,6 +35,7 @@ func MockFinishedMatches() []api.Match {
 				ShortName: "Villa",
 			},
 			Status:    api.MatchStatusFinished,
+			State:     api.MatchState{Phase: api.PhaseFullTime},
 			HomeScore: intPtr(2),
 			AwayScore: intPtr(1),
 			MatchTime: timePtr(today.Add(14 * time.Hour)), // 14:00 today
@@ -59,6 +60,7 @@ func MockFinishedMatches() []api.Match {
 				ShortName: "Athletic",
 			},
 			Status:    api.MatchStatusFinished,
+			State:     api.MatchState{Phase: api.PhaseFullTime},
 			HomeScore: intPtr(0),
 			AwayScore: intPtr(2),
 			MatchTime: timePtr(today.Add(16 * time.Hour)), // 16:00 today
@@ -83,6 +85,7 @@ func MockFinishedMatches() []api.Match {
 				ShortName: "Roma",
 			},
 			Status:    api.MatchStatusFinished,
+			State:     api.MatchState{Phase: api.PhaseFullTime},
 			HomeScore: intPtr(3),
 			AwayScore: intPtr(1),
 			MatchTime: timePtr(today.Add(18 * time.Hour)), // 18:00 today
@@ -111,6 +114,7 @@ func MockFinishedMatches() []api.Match {
 				ShortName: "Arsenal",
 			},
 			Status:    api.MatchStatusFinished,
+			State:     api.MatchState{Phase: api.PhaseFullTime},
 			HomeScore: intPtr(2),
 			AwayScore: intPtr(1),
 			MatchTime: timePtr(now.AddDate(0, 0, -2)),
@@ -135,6 +139,7 @@ func MockFinishedMatches() []api.Match {
 				ShortName: "Liverpool",
 			},
 			Status:    api.MatchStatusFinished,
+			State:     api.MatchState{Phase: api.PhaseFullTime},
 			HomeScore: intPtr(0),
 			AwayScore: intPtr(3),
 			MatchTime: timePtr(now.AddDate(0, 0, -3)),
@@ -163,6 +168,7 @@ func MockFinishedMatches() []api.Match {
 				ShortName: "Barcelona",
 			},
 			Status:    api.MatchStatusFinished,
+			State:     api.MatchState{Phase: api.PhaseFullTime},
 			HomeScore: intPtr(3),
 			AwayScore: intPtr(2),
 			MatchTime: timePtr(now.AddDate(0, 0, -1)),
@@ -187,6 +193,7 @@ func MockFinishedMatches() []api.Match {
 				ShortName: "Sevilla",
 			},
 			Status:    api.MatchStatusFinished,
+			State:     api.MatchState{Phase: api.PhaseFullTime},
 			HomeScore: intPtr(1),
 			AwayScore: intPtr(1),
 			MatchTime: timePtr(now.AddDate(0, 0, -4)),
@@ -194,7 +201,7 @@ func MockFinishedMatches() []api.Match {
 		},
 
 		// ═══════════════════════════════════════════════
-		// UEFA CHAMPIONS LEAGUE (2 matches)
+		// UEFA CHAMPIONS LEAGUE (3 matches)
 		// ═══════════════════════════════════════════════
 
 		// Match 5: PSG 2-3 Bayern (5 days ago)
@@ -215,6 +222,7 @@ func MockFinishedMatches() []api.Match {
 				ShortName: "Bayern",
 			},
 			Status:    api.MatchStatusFinished,
+			State:     api.MatchState{Phase: api.PhaseFullTime},
 			HomeScore: intPtr(2),
 			AwayScore: intPtr(3),
 			MatchTime: timePtr(now.AddDate(0, 0, -5)),
@@ -239,11 +247,39 @@ func MockFinishedMatches() []api.Match {
 				ShortName: "Dortmund",
 			},
 			Status:    api.MatchStatusFinished,
+			State:     api.MatchState{Phase: api.PhaseFullTime},
 			HomeScore: intPtr(1),
 			AwayScore: intPtr(0),
 			MatchTime: timePtr(now.AddDate(0, 0, -6)),
 			Round:     "Round of 16 - 1st Leg",
 		},
+
+		// Match 7: Bayern 0-1 PSG (yesterday) - second leg, 3-3 on aggregate, Bayern win on penalties
+		{
+			ID: 1007,
+			League: api.League{
+				ID:   42,
+				Name: "UEFA Champions League",
+			},
+			HomeTeam: api.Team{
+				ID:        157,
+				Name:      "Bayern Munich",
+				ShortName: "Bayern",
+			},
+			AwayTeam: api.Team{
+				ID:        85,
+				Name:      "Paris Saint-Germain",
+				ShortName: "PSG",
+			},
+			Status:        api.MatchStatusFinished,
+			State:         api.MatchState{Phase: api.PhaseAfterPenalties},
+			HomeScore:     intPtr(0),
+			AwayScore:     intPtr(1),
+			HomeAggregate: intPtr(3),
+			AwayAggregate: intPtr(3),
+			MatchTime:     timePtr(now.AddDate(0, 0, -1)),
+			Round:         "Round of 16 - 2nd Leg",
+		},
 	}
 }
 
@@ -0,0 +1,248 @@
+package data
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// mockLeagueTeams returns the teams appearing in mock matches for a league.
+// Falls back to every mock team when the league has no mock matches.
+func mockLeagueTeams(leagueID int) []api.Team {
+	var teams []api.Team
+	seen := make(map[int]bool)
+	collect := func(matches []api.Match, onlyLeague bool) {
+		for _, match := range matches {
+			if onlyLeague && match.League.ID != leagueID {
+				continue
+			}
+			for _, team := range []api.Team{match.HomeTeam, match.AwayTeam} {
+				if !seen[team.ID] {
+					seen[team.ID] = true
+					teams = append(teams, team)
+				}
+			}
+		}
+	}
+
+	all := append(MockFinishedMatches(), MockLiveMatches()...)
+	collect(all, true)
+	if len(teams) == 0 {
+		collect(all, false)
+	}
+	return teams
+}
+
+// mockGroupStageLeagues are tournaments whose mock standings are split into groups.
+var mockGroupStageLeagues = map[int]bool{
+	50:  true, // UEFA Euro
+	77:  true, // FIFA World Cup
+	289: true, // Africa Cup of Nations
+}
+
+// mockGroupSize is the number of teams per mock tournament group.
+const mockGroupSize = 4
+
+// MockLeagueTables returns standings for mock mode, built from the mock teams.
+// Tournaments get several groups of four; other leagues a single table.
+func MockLeagueTables(leagueID int) ([]api.TableGroup, error) {
+	teams := mockLeagueTeams(leagueID)
+
+	if !mockGroupStageLeagues[leagueID] {
+		name := ""
+		if league, ok := LeagueByID(leagueID); ok {
+			name = league.Name
+		}
+		return []api.TableGroup{{Name: name, Entries: mockTable(teams)}}, nil
+	}
+
+	var groups []api.TableGroup
+	for start := 0; start < len(teams); start += mockGroupSize {
+		end := min(start+mockGroupSize, len(teams))
+		groups = append(groups, api.TableGroup{
+			Name:    fmt.Sprintf("Group %c", 'A'+len(groups)),
+			Entries: mockTable(teams[start:end]),
+		})
+	}
+	return groups, nil
+}
+
+// mockTable builds a ranked table with plausible records for the given teams.
+func mockTable(teams []api.Team) []api.LeagueTableEntry {
+	table := make([]api.LeagueTableEntry, 0, len(teams))
+	for i, team := range teams {
+		won := 12 - i%9
+		drawn := 3 + i%4
+		lost := 20 - won - drawn
+		gf := 2*won + drawn + 5
+		ga := 2*lost + drawn + 3
+		table = append(table, api.LeagueTableEntry{
+			Team:           team,
+			Played:         won + drawn + lost,
+			Won:            won,
+			Drawn:          drawn,
+			Lost:           lost,
+			GoalsFor:       gf,
+			GoalsAgainst:   ga,
+			GoalDifference: gf - ga,
+			Points:         3*won + drawn,
+		})
+	}
+
+	sort.SliceStable(table, func(i, j int) bool {
+		if table[i].Points != table[j].Points {
+			return table[i].Points > table[j].Points
+		}
+		return table[i].GoalDifference > table[j].GoalDifference
+	})
+	for i := range table {
+		table[i].Position = i + 1
+	}
+
+	return table
+}
+
+// MockLeagueLeaders returns season stat leaderboards for mock mode.
+func MockLeagueLeaders(leagueID int) ([]api.LeaderboardCategory, error) {
+	teams := mockLeagueTeams(leagueID)
+	if len(teams) == 0 {
+		return nil, nil
+	}
+	team := func(i int) api.Team { return teams[i%len(teams)] }
+
+	return []api.LeaderboardCategory{
+		{Key: "goals", Title: "Top scorer", Entries: []api.LeaderboardEntry{
+			{Rank: 1, PlayerID: 1, PlayerName: "Mock Striker", Team: team(0), Value: 14},
+			{Rank: 2, PlayerID: 2, PlayerName: "Mock Winger", Team: team(1), Value: 11},
+			{Rank: 3, PlayerID: 3, PlayerName: "Mock Forward", Team: team(2), Value: 9},
+		}},
+		{Key: "goal_assist", Title: "Assists", Entries: []api.LeaderboardEntry{
+			{Rank: 1, PlayerID: 4, PlayerName: "Mock Playmaker", Team: team(1), Value: 8},
+			{Rank: 2, PlayerID: 2, PlayerName: "Mock Winger", Team: team(1), Value: 7},
+			{Rank: 3, PlayerID: 5, PlayerName: "Mock Fullback", Team: team(3), Value: 6},
+		}},
+		{Key: "rating", Title: "FotMob rating", Entries: []api.LeaderboardEntry{
+			{Rank: 1, PlayerID: 4, PlayerName: "Mock Playmaker", Team: team(1), Value: 7.82},
+			{Rank: 2, PlayerID: 1, PlayerName: "Mock Striker", Team: team(0), Value: 7.61},
+			{Rank: 3, PlayerID: 6, PlayerName: "Mock Midfielder", Team: team(2), Value: 7.44},
+		}},
+		{Key: "clean_sheet", Title: "Clean sheets", Entries: []api.LeaderboardEntry{
+			{Rank: 1, PlayerID: 7, PlayerName: "Mock Keeper", Team: team(0), Value: 9},
+			{Rank: 2, PlayerID: 8, PlayerName: "Mock Goalie", Team: team(2), Value: 7},
+			{Rank: 3, PlayerID: 9, PlayerName: "Mock Shotstopper", Team: team(3), Value: 6},
+		}},
+	}, nil
+}
+
+// MockLeagueBracket returns a knockout bracket for mock mode.
+// Quarter-finals are built around the mock finished matches, so their details can be opened:
+// two ties are decided (one on penalties) and two have a second leg still to play.
+// Later rounds are partly undecided.
+func MockLeagueBracket(leagueID int) ([]api.BracketRound, error) {
+	finished := MockFinishedMatches()
+	if len(finished) < 4 {
+		return nil, nil
+	}
+	now := time.Now()
+
+	// reverse builds the other leg of a tie, with home and away swapped
+	reverse := func(played api.Match, id int, home, away *int, kickoff time.Time) api.Match {
+		state := api.MatchState{Phase: api.PhasePreMatch}
+		if home != nil {
+			state.Phase = api.PhaseFullTime
+		}
+		return api.Match{
+			ID:        id,
+			League:    played.League,
+			HomeTeam:  played.AwayTeam,
+			AwayTeam:  played.HomeTeam,
+			Status:    state.Status(),
+			State:     state,
+			HomeScore: home,
+			AwayScore: away,
+			MatchTime: &kickoff,
+		}
+	}
+
+	var quarterFinals []api.BracketTie
+	for i, played := range finished[:4] {
+		playedHome, playedAway := 0, 0
+		if played.HomeScore != nil && played.AwayScore != nil {
+			playedHome, playedAway = *played.HomeScore, *played.AwayScore
+		}
+		playedAt := now
+		if played.MatchTime != nil {
+			playedAt = *played.MatchTime
+		}
+
+		var tie api.BracketTie
+		switch i {
+		case 0: // Decided over two legs; the mock match was the second leg
+			first := reverse(played, 9100+i, intPtr(1), intPtr(1), playedAt.AddDate(0, 0, -7))
+			tie = api.BracketTie{HomeTeam: first.HomeTeam, AwayTeam: first.AwayTeam, Legs: []api.Match{first, played}}
+		case 1: // Level on aggregate, decided on penalties in the second leg (the mock match)
+			first := reverse(played, 9100+i, intPtr(playedHome), intPtr(playedAway), playedAt.AddDate(0, 0, -7))
+			tie = api.BracketTie{HomeTeam: first.HomeTeam, AwayTeam: first.AwayTeam, Legs: []api.Match{first, played}}
+			tie.HomePenalties, tie.AwayPenalties = intPtr(3), intPtr(4)
+		default: // The mock match was the first leg, the second is still to play
+			second := reverse(played, 9100+i, nil, nil, now.AddDate(0, 0, 7+i))
+			tie = api.BracketTie{HomeTeam: played.HomeTeam, AwayTeam: played.AwayTeam, Legs: []api.Match{played, second}}
+		}
+
+		homeAgg, awayAgg := 0, 0
+		for _, leg := range tie.Legs {
+			if leg.HomeScore == nil || leg.AwayScore == nil {
+				continue
+			}
+			if leg.HomeTeam.ID == tie.HomeTeam.ID {
+				homeAgg, awayAgg = homeAgg+*leg.HomeScore, awayAgg+*leg.AwayScore
+			} else {
+				homeAgg, awayAgg = homeAgg+*leg.AwayScore, awayAgg+*leg.HomeScore
+			}
+		}
+		tie.HomeAggregate, tie.AwayAggregate = intPtr(homeAgg), intPtr(awayAgg)
+
+		if tie.Legs[1].Status == api.MatchStatusFinished {
+			switch {
+			case tie.HomePenalties != nil && *tie.HomePenalties > *tie.AwayPenalties:
+				tie.WinnerID = tie.HomeTeam.ID
+			case tie.HomePenalties != nil:
+				tie.WinnerID = tie.AwayTeam.ID
+			case homeAgg >= awayAgg:
+				tie.WinnerID = tie.HomeTeam.ID
+			default:
+				tie.WinnerID = tie.AwayTeam.ID
+			}
+		}
+		quarterFinals = append(quarterFinals, tie)
+	}
+
+	winner := func(tie api.BracketTie) api.Team {
+		if tie.WinnerID == tie.HomeTeam.ID {
+			return tie.HomeTeam
+		}
+		return tie.AwayTeam
+	}
+	semiKickoff := now.AddDate(0, 0, 21)
+	semiFinal := api.BracketTie{
+		HomeTeam: winner(quarterFinals[0]),
+		AwayTeam: winner(quarterFinals[1]),
+	}
+	semiFinal.Legs = []api.Match{{
+		ID:        9200,
+		League:    quarterFinals[0].Legs[0].League,
+		HomeTeam:  semiFinal.HomeTeam,
+		AwayTeam:  semiFinal.AwayTeam,
+		Status:    api.MatchStatusNotStarted,
+		State:     api.MatchState{Phase: api.PhasePreMatch},
+		MatchTime: &semiKickoff,
+	}}
+
+	return []api.BracketRound{
+		{Stage: "Quarter-finals", Ties: quarterFinals},
+		{Stage: "Semi-finals", Ties: []api.BracketTie{semiFinal, {}}},
+		{Stage: "Final", Ties: []api.BracketTie{{}}},
+	}, nil
+}
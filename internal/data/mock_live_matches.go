@@ -36,7 +36,7 @@ func MockLiveMatches() []api.Match {
 			Status:    api.MatchStatusLive,
 			HomeScore: intPtr(2),
 			AwayScore: intPtr(1),
-			LiveTime:  stringPtr("67'"),
+			State:     api.MatchState{Phase: api.PhaseSecondHalf, Minute: 67},
 			MatchTime: &now,
 			Round:     "Matchday 17",
 		},
@@ -61,7 +61,7 @@ func MockLiveMatches() []api.Match {
 			Status:    api.MatchStatusLive,
 			HomeScore: intPtr(1),
 			AwayScore: intPtr(1),
-			LiveTime:  stringPtr("34'"),
+			State:     api.MatchState{Phase: api.PhaseFirstHalf, Minute: 34},
 			MatchTime: &now,
 			Round:     "Matchday 18",
 		},
@@ -86,7 +86,7 @@ func MockLiveMatches() []api.Match {
 			Status:    api.MatchStatusLive,
 			HomeScore: intPtr(3),
 			AwayScore: intPtr(2),
-			LiveTime:  stringPtr("56'"),
+			State:     api.MatchState{Phase: api.PhaseSecondHalf, Minute: 56},
 			MatchTime: &now,
 			Round:     "Round of 16",
 		},
@@ -115,7 +115,7 @@ func MockLiveMatches() []api.Match {
 			Status:    api.MatchStatusFinished,
 			HomeScore: intPtr(2),
 			AwayScore: intPtr(3),
-			LiveTime:  stringPtr("FT"),
+			State:     api.MatchState{Phase: api.PhaseFullTime},
 			MatchTime: &now,
 			Round:     "Matchday 17",
 		},
@@ -140,7 +140,7 @@ func MockLiveMatches() []api.Match {
 			Status:    api.MatchStatusFinished,
 			HomeScore: intPtr(4),
 			AwayScore: intPtr(1),
-			LiveTime:  stringPtr("FT"),
+			State:     api.MatchState{Phase: api.PhaseFullTime},
 			MatchTime: &now,
 			Round:     "Matchday 18",
 		},
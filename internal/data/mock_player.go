@@ -0,0 +1,66 @@
+package data
+
+import (
+	"hash/fnv"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// MockPlayer returns a player profile for mock mode.
+// Mock events don't carry real player IDs, so the name is passed through for display.
+func MockPlayer(playerID int, name string) (*api.Player, error) {
+	now := time.Now()
+
+	ratings := []float64{7.4, 6.8, 8.1, 6.2, 7.0, 7.7, 6.5, 9.1, 7.2, 6.9}
+	recent := make([]api.PlayerMatchRating, 0, len(ratings))
+	for i, rating := range ratings {
+		recent = append(recent, api.PlayerMatchRating{
+			MatchID:    9000 + i,
+			Opponent:   "Mock Opponent",
+			LeagueName: "Premier League",
+			MatchTime:  timePtr(now.AddDate(0, 0, -7*(i+1))),
+			Rating:     rating,
+			Minutes:    90,
+			Goals:      i % 3 / 2,
+		})
+	}
+
+	return &api.Player{
+		ID:          playerID,
+		Name:        name,
+		Position:    "Striker",
+		Team:        api.Team{ID: 39, Name: "Newcastle United", ShortName: "Newcastle"},
+		Nationality: "England",
+		Age:         27,
+		Number:      9,
+		Height:      "185 cm",
+		SeasonStats: []api.PlayerSeasonStats{
+			{LeagueID: 47, LeagueName: "Premier League", Season: "2025/2026", Appearances: 18, Goals: 11, Assists: 3, Minutes: 1490, Rating: 7.31},
+			{LeagueID: 42, LeagueName: "Champions League", Season: "2025/2026", Appearances: 6, Goals: 4, Assists: 1, Minutes: 502, Rating: 7.45},
+		},
+		RecentMatches: recent,
+		Career: []api.PlayerCareerEntry{
+			{TeamID: 39, TeamName: "Newcastle United", StartYear: 2022, Appearances: 112, Goals: 51, Active: true},
+			{TeamID: 8463, TeamName: "Brentford", StartYear: 2019, EndYear: 2022, Appearances: 98, Goals: 34},
+		},
+	}, nil
+}
+
+// assignMockPlayerIDs gives mock events stable player IDs derived from player names,
+// so player profiles can be opened from events in mock mode.
+func assignMockPlayerIDs(events []api.MatchEvent) []api.MatchEvent {
+	for i := range events {
+		if events[i].Player != nil && events[i].PlayerID == 0 {
+			events[i].PlayerID = mockPlayerID(*events[i].Player)
+		}
+	}
+	return events
+}
+
+// mockPlayerID derives a stable positive ID from a player name.
+func mockPlayerID(name string) int {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	return int(h.Sum32()&0x7fffffff) + 1
+}
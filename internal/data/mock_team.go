@@ -0,0 +1,87 @@
+package data
+
+import (
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// MockTeamDetails returns a team page built from the mock live and finished matches.
+// Returns nil if the team doesn't appear in any mock match.
+func MockTeamDetails(teamID int) (*api.TeamDetails, error) {
+	var team *api.TeamDetails
+
+	findTeam := func(match api.Match) {
+		if team != nil {
+			return
+		}
+		switch teamID {
+		case match.HomeTeam.ID:
+			team = &api.TeamDetails{Team: match.HomeTeam, TableLeague: match.League}
+		case match.AwayTeam.ID:
+			team = &api.TeamDetails{Team: match.AwayTeam, TableLeague: match.League}
+		}
+	}
+
+	for _, match := range MockFinishedMatches() {
+		findTeam(match)
+		if match.HomeTeam.ID == teamID || match.AwayTeam.ID == teamID {
+			team.RecentResults = append(team.RecentResults, match)
+		}
+	}
+	for _, match := range MockLiveMatches() {
+		findTeam(match)
+		if match.HomeTeam.ID == teamID || match.AwayTeam.ID == teamID {
+			team.UpcomingFixtures = append(team.UpcomingFixtures, match)
+		}
+	}
+
+	if team == nil {
+		return nil, nil
+	}
+
+	team.TablePosition = &api.LeagueTableEntry{
+		Position: 1 + teamID%18,
+		Team:     team.Team,
+		Played:   18,
+		Won:      10,
+		Drawn:    4,
+		Lost:     4,
+		Points:   34,
+	}
+	team.TopScorers = []api.TeamTopScorer{
+		{ID: 1, Name: "Mock Striker", Goals: 11},
+		{ID: 2, Name: "Mock Winger", Goals: 6},
+		{ID: 3, Name: "Mock Midfielder", Goals: 4},
+	}
+	team.Squad = []api.SquadPlayer{
+		{ID: 10, Name: "Mock Keeper", Number: 1, Position: "Goalkeeper", Age: 29},
+		{ID: 11, Name: "Mock Defender", Number: 4, Position: "Defender", Age: 26},
+		{ID: 12, Name: "Mock Midfielder", Number: 8, Position: "Midfielder", Age: 24},
+		{ID: 2, Name: "Mock Winger", Number: 11, Position: "Attacker", Age: 22},
+		{ID: 1, Name: "Mock Striker", Number: 9, Position: "Attacker", Age: 27},
+	}
+
+	return team, nil
+}
+
+// MockSearchTeams finds teams by name among the mock live and finished matches.
+func MockSearchTeams(query string) []api.TeamSearchResult {
+	query = strings.ToLower(strings.TrimSpace(query))
+	results := []api.TeamSearchResult{}
+	if query == "" {
+		return results
+	}
+
+	seen := make(map[int]bool)
+	for _, match := range append(MockLiveMatches(), MockFinishedMatches()...) {
+		for _, team := range []api.Team{match.HomeTeam, match.AwayTeam} {
+			if seen[team.ID] || !strings.Contains(strings.ToLower(team.Name), query) {
+				continue
+			}
+			seen[team.ID] = true
+			results = append(results, api.TeamSearchResult{Team: team, LeagueID: match.League.ID, League: match.League.Name})
+		}
+	}
+	return results
+}
@@ -3,6 +3,8 @@ package data
 import (
 	"os"
 	"path/filepath"
+	"slices"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -133,6 +135,71 @@ type Settings struct {
 	// SelectedLeagues contains the IDs of leagues the user wants to follow.
 	// If empty, all supported leagues are used.
 	SelectedLeagues []int `yaml:"selected_leagues"`
+
+	// FollowedTeams contains the teams the user follows, in the order they were added.
+	// Their matches are pinned to the top of match lists, and notifications are limited to them.
+	FollowedTeams []FollowedTeam `yaml:"followed_teams,omitempty"`
+
+	// NotificationRules choose which match events notify and through which channels.
+	// If empty, goals and disallowed goals notify on the desktop (followed teams only, if any).
+	NotificationRules []NotificationRule `yaml:"notification_rules,omitempty"`
+
+	// Webhooks are notification channels posting to a URL, named in rules by their name.
+	Webhooks []WebhookTarget `yaml:"webhooks,omitempty"`
+
+	// Hooks are notification channels running a command, named in rules by their name.
+	Hooks []CommandHook `yaml:"hooks,omitempty"`
+
+	// QuietHours mute every notification channel daily over a span of local time.
+	QuietHours *QuietHours `yaml:"quiet_hours,omitempty"`
+}
+
+// FollowedTeam is a followed team, with its name kept for display without an API call.
+// Its league is fetched along with the selected ones, so its matches are listed.
+type FollowedTeam struct {
+	ID       int    `yaml:"id"`
+	Name     string `yaml:"name"`
+	LeagueID int    `yaml:"league_id,omitempty"`
+	League   string `yaml:"league,omitempty"`
+}
+
+// NotificationRule selects match events to notify about and the channels they go to.
+// A rule matches an event when all its fields do; empty fields match everything.
+type NotificationRule struct {
+	Name     string   `yaml:"name,omitempty"`
+	Disabled bool     `yaml:"disabled,omitempty"`
+	Followed bool     `yaml:"followed_teams,omitempty"` // Matches of followed teams (with Teams, either)
+	Teams    []int    `yaml:"teams,omitempty"`          // Team IDs
+	Leagues  []int    `yaml:"leagues,omitempty"`        // League IDs
+	Events   []string `yaml:"events,omitempty"`         // goal, red_card, kickoff, half_time, full_time, var
+	When     []string `yaml:"when,omitempty"`           // Match state, all must hold: losing, winning, drawing, last_10_minutes
+	Channels []string `yaml:"channels,omitempty"`       // Where to notify; desktop if empty
+}
+
+// WebhookTarget is a URL notifications are posted to.
+// The body comes from Template, else from the Preset's payload shape.
+type WebhookTarget struct {
+	Name        string            `yaml:"name"` // Channel name for notification rules
+	URL         string            `yaml:"url"`
+	Preset      string            `yaml:"preset,omitempty"`       // slack, discord or ntfy
+	Template    string            `yaml:"template,omitempty"`     // Go text/template over the event
+	ContentType string            `yaml:"content_type,omitempty"` // For Template; application/json if empty
+	Headers     map[string]string `yaml:"headers,omitempty"`      // Extra request headers, e.g. Authorization
+}
+
+// CommandHook is a command run for each notification, getting the event
+// in GOLAZO_* environment variables and as JSON on stdin.
+type CommandHook struct {
+	Name          string        `yaml:"name"`                     // Channel name for notification rules
+	Command       string        `yaml:"command"`                  // Run by sh -c (cmd /C on Windows)
+	Timeout       time.Duration `yaml:"timeout,omitempty"`        // e.g., 5s; 10s if zero
+	MaxConcurrent int           `yaml:"max_concurrent,omitempty"` // Commands running at once; 2 if zero
+}
+
+// QuietHours is a daily span of local time, e.g. 23:00 to 07:00.
+type QuietHours struct {
+	Start string `yaml:"start"` // "HH:MM"
+	End   string `yaml:"end"`   // "HH:MM"; before Start to span midnight
 }
 
 // SettingsPath returns the path to the settings file.
@@ -195,14 +262,30 @@ var DefaultLeagueIDs = []int{
 
 // ActiveLeagueIDs returns the league IDs that should be used for API calls.
 // If no leagues are selected in settings, returns the default leagues (not all).
+// The followed teams' leagues are always included.
 func ActiveLeagueIDs() []int {
 	settings, err := LoadSettings()
-	if err != nil || len(settings.SelectedLeagues) == 0 {
+	if err != nil {
 		// Return default leagues for efficient API usage
 		return DefaultLeagueIDs
 	}
 
-	return settings.SelectedLeagues
+	leagueIDs := settings.SelectedLeagues
+	if len(leagueIDs) == 0 {
+		leagueIDs = DefaultLeagueIDs
+	}
+	return withFollowedLeagues(leagueIDs, settings.FollowedTeams)
+}
+
+// withFollowedLeagues appends the followed teams' leagues missing from leagueIDs.
+func withFollowedLeagues(leagueIDs []int, teams []FollowedTeam) []int {
+	ids := append([]int{}, leagueIDs...)
+	for _, team := range teams {
+		if team.LeagueID != 0 && !slices.Contains(ids, team.LeagueID) {
+			ids = append(ids, team.LeagueID)
+		}
+	}
+	return ids
 }
 
 // AllLeagueIDs returns all supported league IDs (used as fallback).
@@ -221,6 +304,18 @@ func AllLeagueIDs() []int {
 	return ids
 }
 
+// LeagueByID looks up a supported league's metadata by ID.
+func LeagueByID(leagueID int) (LeagueInfo, bool) {
+	for _, leagues := range AllSupportedLeagues {
+		for _, league := range leagues {
+			if league.ID == leagueID {
+				return league, true
+			}
+		}
+	}
+	return LeagueInfo{}, false
+}
+
 // IsLeagueSelected checks if a league ID is in the selected list.
 func (s *Settings) IsLeagueSelected(leagueID int) bool {
 	for _, id := range s.SelectedLeagues {
@@ -231,6 +326,30 @@ func (s *Settings) IsLeagueSelected(leagueID int) bool {
 	return false
 }
 
+// IsTeamFollowed checks if a team ID is in the followed list.
+func (s *Settings) IsTeamFollowed(teamID int) bool {
+	for _, team := range s.FollowedTeams {
+		if team.ID == teamID {
+			return true
+		}
+	}
+	return false
+}
+
+// FollowedTeamIDs returns the IDs of the followed teams as a set.
+// Returns an empty set if no teams are followed or the settings can't be read.
+func FollowedTeamIDs() map[int]bool {
+	ids := make(map[int]bool)
+	settings, err := LoadSettings()
+	if err != nil {
+		return ids
+	}
+	for _, team := range settings.FollowedTeams {
+		ids[team.ID] = true
+	}
+	return ids
+}
+
 // GetAllRegions returns a list of all available regions in order.
 func GetAllRegions() []string {
 	return []string{RegionEurope, RegionAmerica, RegionGlobal}
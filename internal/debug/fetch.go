@@ -138,6 +138,9 @@ func parseMatchesFromResponse(body []byte, requestDateStr string, leagueID int)
 					LiveTime  *struct {
 						Short string `json:"short"`
 					} `json:"liveTime,omitempty"`
+					Reason *struct {
+						Short string `json:"short"`
+					} `json:"reason,omitempty"`
 					Score *struct {
 						Home int `json:"home"`
 						Away int `json:"away"`
@@ -200,19 +203,20 @@ func parseMatchesFromResponse(body []byte, requestDateStr string, leagueID int)
 			Round:     m.Round,
 		}
 
-		// Determine status
-		if m.Status.Cancelled != nil && *m.Status.Cancelled {
-			match.Status = api.MatchStatusCancelled
-		} else if m.Status.Finished != nil && *m.Status.Finished {
-			match.Status = api.MatchStatusFinished
-		} else if m.Status.Started != nil && *m.Status.Started {
-			match.Status = api.MatchStatusLive
-			if m.Status.LiveTime != nil {
-				match.LiveTime = &m.Status.LiveTime.Short
-			}
-		} else {
-			match.Status = api.MatchStatusNotStarted
+		// Determine status and phase
+		var reason, clock string
+		if m.Status.Reason != nil {
+			reason = m.Status.Reason.Short
+		}
+		if m.Status.LiveTime != nil {
+			clock = m.Status.LiveTime.Short
 		}
+		match.State = fotmob.ParseMatchState(
+			m.Status.Started != nil && *m.Status.Started,
+			m.Status.Finished != nil && *m.Status.Finished,
+			m.Status.Cancelled != nil && *m.Status.Cancelled,
+			reason, clock)
+		match.Status = match.State.Status()
 
 		// Set scores
 		if m.Status.Score != nil {
@@ -12,6 +12,8 @@ type CacheConfig struct {
 	MatchesTTL      time.Duration // How long to cache match list results
 	MatchDetailsTTL time.Duration // How long to cache match details
 	LiveMatchesTTL  time.Duration // How long to cache live matches list
+	TeamTTL         time.Duration // How long to cache team details
+	LeagueTTL       time.Duration // How long to cache league pages (table, leaders, bracket)
 	MaxMatchesCache int           // Maximum number of date entries to cache
 	MaxDetailsCache int           // Maximum number of match details to cache
 }
@@ -22,6 +24,8 @@ func DefaultCacheConfig() CacheConfig {
 		MatchesTTL:      15 * time.Minute, // Matches list cache (stats view uses client-side filtering)
 		MatchDetailsTTL: 5 * time.Minute,  // Details for live matches need fresher data
 		LiveMatchesTTL:  2 * time.Minute,  // Live matches list cache (quick nav doesn't re-fetch)
+		TeamTTL:         15 * time.Minute, // Team pages change only when matches finish
+		LeagueTTL:       5 * time.Minute,  // One league page backs the table, leaders and bracket tabs
 		MaxMatchesCache: 10,               // Cache up to 10 date queries
 		MaxDetailsCache: 100,              // Cache up to 100 match details
 	}
@@ -39,6 +43,18 @@ type cachedDetails struct {
 	expiresAt time.Time
 }
 
+// cachedTeam holds cached team details with expiration.
+type cachedTeam struct {
+	team      *api.TeamDetails
+	expiresAt time.Time
+}
+
+// cachedLeague holds a decoded league page with expiration.
+type cachedLeague struct {
+	response  *fotmobLeagueResponse
+	expiresAt time.Time
+}
+
 // ResponseCache provides thread-safe caching for API responses.
 type ResponseCache struct {
 	config       CacheConfig
@@ -48,6 +64,10 @@ type ResponseCache struct {
 	detailsCache map[int]cachedDetails // key: matchID
 	liveMu       sync.RWMutex
 	liveCache    *cachedMatches // Single cache entry for live matches
+	teamsMu      sync.RWMutex
+	teamsCache   map[int]cachedTeam // key: teamID
+	leaguesMu    sync.RWMutex
+	leaguesCache map[int]cachedLeague // key: leagueID
 }
 
 // NewResponseCache creates a new cache with the given configuration.
@@ -57,6 +77,8 @@ func NewResponseCache(config CacheConfig) *ResponseCache {
 		matchesCache: make(map[string]cachedMatches),
 		detailsCache: make(map[int]cachedDetails),
 		liveCache:    nil,
+		teamsCache:   make(map[int]cachedTeam),
+		leaguesCache: make(map[int]cachedLeague),
 	}
 }
 
@@ -123,6 +145,52 @@ func (c *ResponseCache) SetDetails(matchID int, details *api.MatchDetails) {
 	}
 }
 
+// Team retrieves cached team details, returns nil if not cached or expired.
+func (c *ResponseCache) Team(teamID int) *api.TeamDetails {
+	c.teamsMu.RLock()
+	defer c.teamsMu.RUnlock()
+
+	cached, ok := c.teamsCache[teamID]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return nil
+	}
+	return cached.team
+}
+
+// SetTeam stores team details in cache with TTL.
+func (c *ResponseCache) SetTeam(teamID int, team *api.TeamDetails) {
+	c.teamsMu.Lock()
+	defer c.teamsMu.Unlock()
+
+	c.teamsCache[teamID] = cachedTeam{
+		team:      team,
+		expiresAt: time.Now().Add(c.config.TeamTTL),
+	}
+}
+
+// league retrieves a cached league page, returns nil if not cached or expired.
+func (c *ResponseCache) league(leagueID int) *fotmobLeagueResponse {
+	c.leaguesMu.RLock()
+	defer c.leaguesMu.RUnlock()
+
+	cached, ok := c.leaguesCache[leagueID]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return nil
+	}
+	return cached.response
+}
+
+// setLeague stores a decoded league page in cache with TTL.
+func (c *ResponseCache) setLeague(leagueID int, response *fotmobLeagueResponse) {
+	c.leaguesMu.Lock()
+	defer c.leaguesMu.Unlock()
+
+	c.leaguesCache[leagueID] = cachedLeague{
+		response:  response,
+		expiresAt: time.Now().Add(c.config.LeagueTTL),
+	}
+}
+
 // GetCachedMatchIDs returns all match IDs currently in the details cache.
 func (c *ResponseCache) CachedMatchIDs() []int {
 	c.detailsMu.RLock()
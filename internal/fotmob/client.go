@@ -431,45 +431,3 @@ func (c *Client) LeagueMatches(ctx context.Context, leagueID int) ([]api.Match,
 	// In a real implementation, you'd use: /api/leagues?id={leagueID}
 	return []api.Match{}, nil
 }
-
-// LeagueTable retrieves the league table/standings for a specific league.
-func (c *Client) LeagueTable(ctx context.Context, leagueID int) ([]api.LeagueTableEntry, error) {
-	// Apply rate limiting
-	c.rateLimiter.Wait()
-
-	url := fmt.Sprintf("%s/leagues?id=%d", c.baseURL, leagueID)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("create request for league %d table: %w", leagueID, err)
-	}
-
-	req.Header.Set("User-Agent", "Mozilla/5.0")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("fetch league table for league %d: %w", leagueID, err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code %d for league %d table", resp.StatusCode, leagueID)
-	}
-
-	var response struct {
-		Data struct {
-			Table []fotmobTableRow `json:"table"`
-		} `json:"data"`
-	}
-
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, fmt.Errorf("decode league table response for league %d: %w", leagueID, err)
-	}
-
-	entries := make([]api.LeagueTableEntry, 0, len(response.Data.Table))
-	for _, row := range response.Data.Table {
-		entries = append(entries, row.toAPITableEntry())
-	}
-
-	return entries, nil
-}
@@ -0,0 +1,168 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// commentaryLanguage is the language of the live ticker feed.
+const commentaryLanguage = "en"
+
+// fotmobCommentary is the live ticker ("ltc") response. The match details only
+// advertise the feed (content.liveticker); the lines come from this separate endpoint.
+type fotmobCommentary struct {
+	Events []fotmobCommentaryEvent `json:"events"`
+}
+
+// fotmobCommentaryEvent is one ticker line. Field names vary between feeds,
+// so the minute and type are read from several candidates.
+type fotmobCommentaryEvent struct {
+	ID          json.RawMessage `json:"id"`
+	Type        string          `json:"type"`
+	Text        string          `json:"text"`
+	IsImportant bool            `json:"isImportant"`
+	Important   bool            `json:"important"`
+	Elapsed     json.RawMessage `json:"elapsed"`
+	Minute      json.RawMessage `json:"minute"`
+	ElapsedPlus json.RawMessage `json:"elapsedPlus"`
+	Overload    json.RawMessage `json:"overloadTime"`
+	Time        json.RawMessage `json:"time"` // "90+3" or {"main": "90+3'"}
+}
+
+// Commentary retrieves the live text commentary for a match, newest first.
+// The team names are part of the feed request, as on FotMob's match page.
+// Matches without commentary return an empty slice.
+func (c *Client) Commentary(ctx context.Context, matchID int, homeTeam, awayTeam string) ([]api.CommentaryEntry, error) {
+	// Apply rate limiting
+	c.rateLimiter.Wait()
+
+	teams, err := json.Marshal([]string{homeTeam, awayTeam})
+	if err != nil {
+		return nil, fmt.Errorf("encode teams for match %d: %w", matchID, err)
+	}
+	feed := fmt.Sprintf("data.fotmob.com/webcl/ltc/gsm/%d_%s.json.gz", matchID, commentaryLanguage)
+	requestURL := fmt.Sprintf("%s/ltc?ltcUrl=%s&teams=%s", c.baseURL, url.QueryEscape(feed), url.QueryEscape(string(teams)))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create commentary request for match %d: %w", matchID, err)
+	}
+
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch commentary for match %d: %w", matchID, err)
+	}
+	defer resp.Body.Close()
+
+	// No ticker for this match (lower leagues, friendlies)
+	if resp.StatusCode == http.StatusNotFound {
+		return []api.CommentaryEntry{}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for match %d commentary", resp.StatusCode, matchID)
+	}
+
+	var response fotmobCommentary
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode commentary for match %d: %w", matchID, err)
+	}
+
+	return response.toAPICommentary(), nil
+}
+
+// toAPICommentary converts the ticker lines, dropping empty ones.
+// The feed is already newest first.
+func (r fotmobCommentary) toAPICommentary() []api.CommentaryEntry {
+	entries := make([]api.CommentaryEntry, 0, len(r.Events))
+	for _, e := range r.Events {
+		text := strings.TrimSpace(e.Text)
+		if text == "" {
+			continue
+		}
+
+		entry := api.CommentaryEntry{
+			ID:        parseRawString(e.ID),
+			Kind:      commentaryKind(e.Type),
+			Text:      text,
+			Important: e.IsImportant || e.Important,
+		}
+		if entry.ID == "" {
+			if id := parseRawID(e.ID); id != 0 {
+				entry.ID = fmt.Sprint(id)
+			}
+		}
+
+		entry.Minute = int(parseRawFloat(e.Elapsed))
+		if entry.Minute == 0 {
+			entry.Minute = int(parseRawFloat(e.Minute))
+		}
+		entry.AddedTime = int(parseRawFloat(e.ElapsedPlus))
+		if entry.AddedTime == 0 {
+			entry.AddedTime = int(parseRawFloat(e.Overload))
+		}
+		if entry.Minute == 0 {
+			entry.Minute, entry.AddedTime = commentaryTime(e.Time)
+		}
+
+		// Key moments are highlighted even when the feed doesn't flag them
+		if entry.Kind != api.EventOther && entry.Kind != api.EventSubstitution {
+			entry.Important = true
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// commentaryTime reads a ticker time given as "90+3" or {"main": "90+3'"}.
+func commentaryTime(raw json.RawMessage) (minute, added int) {
+	text := parseRawString(raw)
+	if text == "" {
+		var t struct {
+			Main string `json:"main"`
+		}
+		if err := json.Unmarshal(raw, &t); err == nil {
+			text = t.Main
+		}
+	}
+	if text == "" {
+		return 0, 0
+	}
+	state := clockState(text)
+	return state.Minute, state.AddedTime
+}
+
+// commentaryKind maps a ticker line type (e.g., "goal", "yellow_card", "substitution") to an event kind.
+func commentaryKind(lineType string) api.EventKind {
+	t := strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(lineType))
+
+	switch t {
+	case "goal":
+		return api.EventGoal
+	case "owngoal":
+		return api.EventOwnGoal
+	case "penaltygoal", "penaltyscored":
+		return api.EventPenaltyGoal
+	case "missedpenalty", "penaltymissed", "penaltysaved":
+		return api.EventMissedPenalty
+	case "yellow", "yellowcard":
+		return api.EventYellowCard
+	case "secondyellow", "secondyellowcard", "yellowred", "yellowredcard":
+		return api.EventSecondYellow
+	case "red", "redcard":
+		return api.EventRedCard
+	case "substitution", "sub":
+		return api.EventSubstitution
+	case "var", "vardecision":
+		return api.EventVAR
+	default:
+		return api.EventOther
+	}
+}
@@ -0,0 +1,40 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestToAPICommentary(t *testing.T) {
+	raw := `{"events": [
+		{"id": 3, "type": "goal", "text": "Goal! Home 1, Away 0.", "elapsed": 90, "elapsedPlus": 2},
+		{"id": "b", "type": "yellow_card", "text": "Booked.", "time": {"main": "45+1'"}},
+		{"id": 1, "type": "comment", "text": "  ", "elapsed": 10},
+		{"type": "comment", "text": "Kick-off.", "minute": "1", "isImportant": false}
+	]}`
+
+	var response fotmobCommentary
+	if err := json.Unmarshal([]byte(raw), &response); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	got := response.toAPICommentary()
+
+	want := []api.CommentaryEntry{
+		{ID: "3", Minute: 90, AddedTime: 2, Kind: api.EventGoal, Text: "Goal! Home 1, Away 0.", Important: true},
+		{ID: "b", Minute: 45, AddedTime: 1, Kind: api.EventYellowCard, Text: "Booked.", Important: true},
+		{Minute: 1, Kind: api.EventOther, Text: "Kick-off."},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("toAPICommentary() returned %d entries; want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("entry %d = %+v; want %+v", i, got[i], want[i])
+		}
+	}
+	if key := got[2].Key(); key != "1' Kick-off." {
+		t.Errorf("Key() without ID = %q; want %q", key, "1' Kick-off.")
+	}
+}
@@ -0,0 +1,371 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// playoffStageNames maps FotMob playoff stage keys to display names.
+var playoffStageNames = map[string]string{
+	"1/64":  "Round of 128",
+	"1/32":  "Round of 64",
+	"1/16":  "Round of 32",
+	"1/8":   "Round of 16",
+	"1/4":   "Quarter-finals",
+	"1/2":   "Semi-finals",
+	"final": "Final",
+}
+
+// fotmobLeagueResponse represents the FotMob /leagues response (only the parts we use).
+type fotmobLeagueResponse struct {
+	Table   []fotmobTableBlock `json:"table"`
+	Stats   fotmobLeagueStats  `json:"stats"`
+	Playoff *fotmobPlayoff     `json:"playoff"`
+}
+
+// fotmobTableBlock represents a standings block, as found on the league page and the team overview.
+// A league may have several blocks (e.g., per stage).
+type fotmobTableBlock struct {
+	Data fotmobTableData `json:"data"`
+}
+
+// fotmobTableData holds either a single table or, for composite tables such as
+// tournament groups, a list of named sub-tables.
+type fotmobTableData struct {
+	LeagueID   int             `json:"leagueId"`
+	LeagueName string          `json:"leagueName"`
+	Table      *fotmobTableAll `json:"table"`
+	Tables     []struct {
+		LeagueName string         `json:"leagueName"` // Group name, e.g., "Grp. A"
+		Table      fotmobTableAll `json:"table"`
+	} `json:"tables"`
+}
+
+// fotmobTableAll holds the overall standings rows (FotMob also sends home and away splits).
+type fotmobTableAll struct {
+	All []fotmobStandingRow `json:"all"`
+}
+
+// fotmobLeagueStats represents the season stat leaderboards block of the /leagues response.
+type fotmobLeagueStats struct {
+	Players []struct {
+		Header   string `json:"header"` // e.g., "Top scorer"
+		Name     string `json:"name"`   // e.g., "goals"
+		TopThree []struct {
+			ID       int             `json:"id"`
+			Name     string          `json:"name"`
+			TeamID   int             `json:"teamId"`
+			TeamName string          `json:"teamName"`
+			Value    json.RawMessage `json:"value"` // number or string
+			Rank     int             `json:"rank"`
+		} `json:"topThree"`
+	} `json:"players"`
+}
+
+// fotmobPlayoff represents the knockout bracket block of the /leagues response.
+type fotmobPlayoff struct {
+	Rounds []struct {
+		Stage    string          `json:"stage"` // e.g., "1/8", "final"
+		Matchups []fotmobMatchup `json:"matchups"`
+	} `json:"rounds"`
+}
+
+// fotmobMatchup represents one tie in a playoff round.
+// Team IDs and scores are sometimes strings, so they are decoded leniently.
+type fotmobMatchup struct {
+	HomeTeamID        json.RawMessage      `json:"homeTeamId"`
+	HomeTeam          string               `json:"homeTeam"`
+	HomeTeamShortName string               `json:"homeTeamShortName"`
+	AwayTeamID        json.RawMessage      `json:"awayTeamId"`
+	AwayTeam          string               `json:"awayTeam"`
+	AwayTeamShortName string               `json:"awayTeamShortName"`
+	Winner            json.RawMessage      `json:"winner"` // Winning team ID, once decided
+	Matches           []fotmobPlayoffMatch `json:"matches"`
+}
+
+// fotmobPlayoffMatch represents a single leg of a playoff tie.
+type fotmobPlayoffMatch struct {
+	MatchID json.RawMessage   `json:"matchId"`
+	Home    fotmobPlayoffSide `json:"home"`
+	Away    fotmobPlayoffSide `json:"away"`
+	Status  struct {
+		UTCTime   string            `json:"utcTime"`
+		Started   bool              `json:"started"`
+		Finished  bool              `json:"finished"`
+		Cancelled bool              `json:"cancelled"`
+		ScoreStr  string            `json:"scoreStr"`
+		Reason    *fotmobReason     `json:"reason"`    // e.g., "FT", "AET", "Pen"
+		Penalties []json.RawMessage `json:"penalties"` // [home, away] shootout score, when present
+	} `json:"status"`
+}
+
+// fotmobPlayoffSide is one team of a playoff leg.
+type fotmobPlayoffSide struct {
+	ID        json.RawMessage `json:"id"`
+	Name      string          `json:"name"`
+	ShortName string          `json:"shortName"`
+	Score     json.RawMessage `json:"score"`
+}
+
+// fetchLeague retrieves the FotMob /leagues page for a league.
+// The decoded page is cached, so the table, leaders and bracket of a league share one request.
+// what names the requested part in errors (e.g., "leaders").
+func (c *Client) fetchLeague(ctx context.Context, leagueID int, what string) (*fotmobLeagueResponse, error) {
+	if cached := c.cache.league(leagueID); cached != nil {
+		return cached, nil
+	}
+
+	// Apply rate limiting
+	c.rateLimiter.Wait()
+
+	url := fmt.Sprintf("%s/leagues?id=%d", c.baseURL, leagueID)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request for league %d %s: %w", leagueID, what, err)
+	}
+
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch %s for league %d: %w", what, leagueID, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for league %d %s", resp.StatusCode, leagueID, what)
+	}
+
+	var response fotmobLeagueResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode %s response for league %d: %w", what, leagueID, err)
+	}
+
+	c.cache.setLeague(leagueID, &response)
+	return &response, nil
+}
+
+// LeagueTables retrieves a league's standings as one or more tables.
+// Regular leagues return a single group; tournaments return one group per table (e.g., per group stage group).
+func (c *Client) LeagueTables(ctx context.Context, leagueID int) ([]api.TableGroup, error) {
+	response, err := c.fetchLeague(ctx, leagueID, "table")
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []api.TableGroup
+	for _, block := range response.Table {
+		groups = append(groups, block.Data.toAPITableGroups()...)
+	}
+	return groups, nil
+}
+
+// LeagueTable retrieves a league's standings as a single list, concatenating groups.
+// Positions stay relative to each group.
+func (c *Client) LeagueTable(ctx context.Context, leagueID int) ([]api.LeagueTableEntry, error) {
+	groups, err := c.LeagueTables(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []api.LeagueTableEntry
+	for _, group := range groups {
+		entries = append(entries, group.Entries...)
+	}
+	return entries, nil
+}
+
+// LeagueLeaders retrieves the season stat leaderboards (goals, assists, rating, clean sheets, ...)
+// published on FotMob's league page. Each category holds the current top three.
+func (c *Client) LeagueLeaders(ctx context.Context, leagueID int) ([]api.LeaderboardCategory, error) {
+	response, err := c.fetchLeague(ctx, leagueID, "leaders")
+	if err != nil {
+		return nil, err
+	}
+	return response.Stats.toAPILeaderboards(), nil
+}
+
+// LeagueBracket retrieves the knockout bracket of a cup competition.
+// Returns an empty slice for leagues without a playoff stage.
+func (c *Client) LeagueBracket(ctx context.Context, leagueID int) ([]api.BracketRound, error) {
+	response, err := c.fetchLeague(ctx, leagueID, "bracket")
+	if err != nil {
+		return nil, err
+	}
+	if response.Playoff == nil {
+		return nil, nil
+	}
+	return response.Playoff.toAPIBracket(leagueID), nil
+}
+
+// toAPITableGroups converts a table block into table groups.
+// A single table is named after the league; composite tables use their sub-table names.
+func (d fotmobTableData) toAPITableGroups() []api.TableGroup {
+	if d.Table != nil {
+		return []api.TableGroup{{Name: d.LeagueName, Entries: toAPITableEntries(d.Table.All)}}
+	}
+
+	groups := make([]api.TableGroup, 0, len(d.Tables))
+	for _, t := range d.Tables {
+		groups = append(groups, api.TableGroup{Name: t.LeagueName, Entries: toAPITableEntries(t.Table.All)})
+	}
+	return groups
+}
+
+// allRows returns the overall standings rows, flattening grouped tables.
+func (d fotmobTableData) allRows() []fotmobStandingRow {
+	if d.Table != nil {
+		return d.Table.All
+	}
+	var rows []fotmobStandingRow
+	for _, t := range d.Tables {
+		rows = append(rows, t.Table.All...)
+	}
+	return rows
+}
+
+// toAPITableEntries converts standings rows to table entries.
+func toAPITableEntries(rows []fotmobStandingRow) []api.LeagueTableEntry {
+	entries := make([]api.LeagueTableEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, row.toAPITableEntry())
+	}
+	return entries
+}
+
+// toAPILeaderboards converts FotMob player stat blocks into leaderboard categories.
+// Categories without entries are dropped.
+func (s fotmobLeagueStats) toAPILeaderboards() []api.LeaderboardCategory {
+	categories := make([]api.LeaderboardCategory, 0, len(s.Players))
+	for _, p := range s.Players {
+		category := api.LeaderboardCategory{
+			Key:   p.Name,
+			Title: p.Header,
+		}
+		for i, e := range p.TopThree {
+			rank := e.Rank
+			if rank == 0 {
+				rank = i + 1
+			}
+			category.Entries = append(category.Entries, api.LeaderboardEntry{
+				Rank:       rank,
+				PlayerID:   e.ID,
+				PlayerName: e.Name,
+				Team:       api.Team{ID: e.TeamID, Name: e.TeamName, ShortName: e.TeamName},
+				Value:      parseRawFloat(e.Value),
+			})
+		}
+		if len(category.Entries) > 0 {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+// toAPIBracket converts FotMob playoff rounds into bracket rounds, earliest round first.
+func (p fotmobPlayoff) toAPIBracket(leagueID int) []api.BracketRound {
+	rounds := make([]api.BracketRound, 0, len(p.Rounds))
+	for _, r := range p.Rounds {
+		stage, ok := playoffStageNames[strings.ToLower(r.Stage)]
+		if !ok {
+			stage = r.Stage
+		}
+		round := api.BracketRound{Stage: stage}
+		for _, m := range r.Matchups {
+			round.Ties = append(round.Ties, m.toAPITie(leagueID))
+		}
+		rounds = append(rounds, round)
+	}
+	return rounds
+}
+
+// toAPITie converts a FotMob matchup into a bracket tie.
+// The aggregate is summed per team over the legs that have started, since
+// the second leg swaps home and away.
+func (m fotmobMatchup) toAPITie(leagueID int) api.BracketTie {
+	tie := api.BracketTie{
+		HomeTeam: api.Team{ID: parseRawID(m.HomeTeamID), Name: m.HomeTeam, ShortName: m.HomeTeamShortName},
+		AwayTeam: api.Team{ID: parseRawID(m.AwayTeamID), Name: m.AwayTeam, ShortName: m.AwayTeamShortName},
+		WinnerID: parseRawID(m.Winner),
+	}
+	if tie.HomeTeam.ShortName == "" {
+		tie.HomeTeam.ShortName = tie.HomeTeam.Name
+	}
+	if tie.AwayTeam.ShortName == "" {
+		tie.AwayTeam.ShortName = tie.AwayTeam.Name
+	}
+
+	var homeAgg, awayAgg int
+	started := false
+	for _, leg := range m.Matches {
+		match := leg.toAPIMatch(leagueID)
+		tie.Legs = append(tie.Legs, match)
+
+		if match.HomeScore == nil || match.AwayScore == nil {
+			continue
+		}
+		started = true
+		if match.HomeTeam.ID == tie.AwayTeam.ID && tie.AwayTeam.ID != 0 {
+			homeAgg += *match.AwayScore
+			awayAgg += *match.HomeScore
+		} else {
+			homeAgg += *match.HomeScore
+			awayAgg += *match.AwayScore
+		}
+
+		// Shootout score from the deciding leg, oriented to the tie
+		if len(leg.Status.Penalties) == 2 {
+			homePens, awayPens := int(parseRawFloat(leg.Status.Penalties[0])), int(parseRawFloat(leg.Status.Penalties[1]))
+			if match.HomeTeam.ID == tie.AwayTeam.ID && tie.AwayTeam.ID != 0 {
+				homePens, awayPens = awayPens, homePens
+			}
+			tie.HomePenalties = &homePens
+			tie.AwayPenalties = &awayPens
+		}
+	}
+	if started {
+		tie.HomeAggregate = &homeAgg
+		tie.AwayAggregate = &awayAgg
+	}
+
+	return tie
+}
+
+// toAPIMatch converts a playoff leg to api.Match.
+func (l fotmobPlayoffMatch) toAPIMatch(leagueID int) api.Match {
+	match := api.Match{
+		ID:        parseRawID(l.MatchID),
+		League:    api.League{ID: leagueID},
+		HomeTeam:  api.Team{ID: parseRawID(l.Home.ID), Name: l.Home.Name, ShortName: l.Home.ShortName},
+		AwayTeam:  api.Team{ID: parseRawID(l.Away.ID), Name: l.Away.Name, ShortName: l.Away.ShortName},
+		MatchTime: parseTimeOrNil(l.Status.UTCTime),
+	}
+	if match.HomeTeam.ShortName == "" {
+		match.HomeTeam.ShortName = match.HomeTeam.Name
+	}
+	if match.AwayTeam.ShortName == "" {
+		match.AwayTeam.ShortName = match.AwayTeam.Name
+	}
+
+	match.State = ParseMatchState(l.Status.Started, l.Status.Finished, l.Status.Cancelled, l.Status.Reason.text(), "")
+	match.Status = match.State.Status()
+
+	if match.Status == api.MatchStatusFinished || match.Status == api.MatchStatusLive {
+		home, away, ok := parseScoreString(l.Status.ScoreStr)
+		if !ok && len(l.Home.Score) > 0 && len(l.Away.Score) > 0 {
+			home, away, ok = int(parseRawFloat(l.Home.Score)), int(parseRawFloat(l.Away.Score)), true
+		}
+		if ok {
+			match.HomeScore = &home
+			match.AwayScore = &away
+		}
+	}
+
+	return match
+}
@@ -0,0 +1,141 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestToAPITie(t *testing.T) {
+	tests := []struct {
+		desc                       string
+		input                      string
+		wantHomeAgg, wantAwayAgg   int // -1 when no leg has started
+		wantHomePens, wantAwayPens int // -1 when there was no shootout
+		wantWinner                 int
+	}{
+		{
+			desc: "second leg reversed",
+			input: `{"homeTeamId": 1, "homeTeam": "Home", "awayTeamId": "2", "awayTeam": "Away", "winner": 2, "matches": [
+				{"matchId": 11, "home": {"id": 1, "name": "Home"}, "away": {"id": 2, "name": "Away"},
+					"status": {"started": true, "finished": true, "scoreStr": "2 - 1"}},
+				{"matchId": 12, "home": {"id": "2", "name": "Away"}, "away": {"id": 1, "name": "Home"},
+					"status": {"started": true, "finished": true, "scoreStr": "3 - 0"}}
+			]}`,
+			wantHomeAgg: 2, wantAwayAgg: 4,
+			wantHomePens: -1, wantAwayPens: -1,
+			wantWinner: 2,
+		},
+		{
+			desc: "reversed second leg decided on penalties",
+			input: `{"homeTeamId": 1, "homeTeam": "Home", "awayTeamId": 2, "awayTeam": "Away", "winner": "1", "matches": [
+				{"matchId": 11, "home": {"id": 1, "name": "Home"}, "away": {"id": 2, "name": "Away"},
+					"status": {"started": true, "finished": true, "scoreStr": "0 - 1"}},
+				{"matchId": 12, "home": {"id": 2, "name": "Away", "score": 0}, "away": {"id": 1, "name": "Home", "score": 1},
+					"status": {"started": true, "finished": true, "reason": {"short": "Pen"}, "penalties": [3, 4]}}
+			]}`,
+			wantHomeAgg: 1, wantAwayAgg: 1,
+			wantHomePens: 4, wantAwayPens: 3,
+			wantWinner: 1,
+		},
+		{
+			desc: "second leg not played yet",
+			input: `{"homeTeamId": 1, "homeTeam": "Home", "awayTeamId": 2, "awayTeam": "Away", "matches": [
+				{"matchId": 11, "home": {"id": 1, "name": "Home"}, "away": {"id": 2, "name": "Away"},
+					"status": {"started": true, "finished": true, "scoreStr": "1 - 1"}},
+				{"matchId": 12, "home": {"id": 2, "name": "Away"}, "away": {"id": 1, "name": "Home"},
+					"status": {"utcTime": "2026-03-11T20:00:00Z"}}
+			]}`,
+			wantHomeAgg: 1, wantAwayAgg: 1,
+			wantHomePens: -1, wantAwayPens: -1,
+		},
+		{
+			desc: "not started",
+			input: `{"homeTeamId": 1, "homeTeam": "Home", "awayTeamId": 2, "awayTeam": "Away", "matches": [
+				{"matchId": 11, "home": {"id": 1, "name": "Home"}, "away": {"id": 2, "name": "Away"}, "status": {}}
+			]}`,
+			wantHomeAgg: -1, wantAwayAgg: -1,
+			wantHomePens: -1, wantAwayPens: -1,
+		},
+	}
+
+	orNone := func(p *int) int {
+		if p == nil {
+			return -1
+		}
+		return *p
+	}
+
+	for _, tt := range tests {
+		var m fotmobMatchup
+		if err := json.Unmarshal([]byte(tt.input), &m); err != nil {
+			t.Fatalf("%s: unmarshal: %v", tt.desc, err)
+		}
+		tie := m.toAPITie(42)
+
+		if home, away := orNone(tie.HomeAggregate), orNone(tie.AwayAggregate); home != tt.wantHomeAgg || away != tt.wantAwayAgg {
+			t.Errorf("%s: toAPITie() aggregate = %d - %d; want %d - %d", tt.desc, home, away, tt.wantHomeAgg, tt.wantAwayAgg)
+		}
+		if home, away := orNone(tie.HomePenalties), orNone(tie.AwayPenalties); home != tt.wantHomePens || away != tt.wantAwayPens {
+			t.Errorf("%s: toAPITie() penalties = %d - %d; want %d - %d", tt.desc, home, away, tt.wantHomePens, tt.wantAwayPens)
+		}
+		if tie.WinnerID != tt.wantWinner {
+			t.Errorf("%s: toAPITie() WinnerID = %d; want %d", tt.desc, tie.WinnerID, tt.wantWinner)
+		}
+		if len(tie.Legs) != len(m.Matches) {
+			t.Errorf("%s: toAPITie() returned %d legs; want %d", tt.desc, len(tie.Legs), len(m.Matches))
+		}
+	}
+}
+
+func TestToAPILeaderboards(t *testing.T) {
+	raw := `{"players": [
+		{"header": "Top scorer", "name": "goals", "topThree": [
+			{"id": 1, "name": "Haaland", "teamId": 8456, "teamName": "Man City", "value": 22, "rank": 1},
+			{"id": 2, "name": "Isak", "teamId": 10261, "teamName": "Newcastle", "value": "18"}
+		]},
+		{"header": "Rating", "name": "rating", "topThree": [{"id": 3, "name": "Saka", "value": "7.9", "rank": 1}, {"name": "No team", "value": null}]},
+		{"header": "Assists", "name": "goal_assist", "topThree": []},
+		{"header": "Clean sheets", "name": "clean_sheet"}
+	]}`
+
+	var stats fotmobLeagueStats
+	if err := json.Unmarshal([]byte(raw), &stats); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	got := stats.toAPILeaderboards()
+
+	want := []api.LeaderboardCategory{
+		{Key: "goals", Title: "Top scorer", Entries: []api.LeaderboardEntry{
+			{Rank: 1, PlayerID: 1, PlayerName: "Haaland", Team: api.Team{ID: 8456, Name: "Man City", ShortName: "Man City"}, Value: 22},
+			{Rank: 2, PlayerID: 2, PlayerName: "Isak", Team: api.Team{ID: 10261, Name: "Newcastle", ShortName: "Newcastle"}, Value: 18},
+		}},
+		{Key: "rating", Title: "Rating", Entries: []api.LeaderboardEntry{
+			{Rank: 1, PlayerID: 3, PlayerName: "Saka", Value: 7.9},
+			{Rank: 2, PlayerName: "No team"},
+		}},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("toAPILeaderboards() returned %d categories; want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i].Key != want[i].Key || got[i].Title != want[i].Title || len(got[i].Entries) != len(want[i].Entries) {
+			t.Errorf("category %d = %+v; want %+v", i, got[i], want[i])
+			continue
+		}
+		for j := range want[i].Entries {
+			if got[i].Entries[j] != want[i].Entries[j] {
+				t.Errorf("category %d entry %d = %+v; want %+v", i, j, got[i].Entries[j], want[i].Entries[j])
+			}
+		}
+	}
+
+	var empty fotmobLeagueStats
+	if err := json.Unmarshal([]byte(`{"players": null}`), &empty); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got := empty.toAPILeaderboards(); len(got) != 0 {
+		t.Errorf("toAPILeaderboards() without players = %+v; want none", got)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -53,28 +54,36 @@ func (c *Client) LiveMatchesForceRefresh(ctx context.Context) ([]api.Match, erro
 // LiveMatchesForLeague fetches live matches for a single league.
 // Used for progressive loading - results appear as each league responds.
 func (c *Client) LiveMatchesForLeague(ctx context.Context, leagueID int) ([]api.Match, error) {
+	live, _, err := c.TodayMatchesForLeague(ctx, leagueID)
+	return live, err
+}
+
+// TodayMatchesForLeague fetches today's live and not-yet-started (or postponed) matches for a single league.
+// Both come from the same "fixtures" request, so the live view gets upcoming matches for free.
+func (c *Client) TodayMatchesForLeague(ctx context.Context, leagueID int) (live []api.Match, upcoming []api.Match, err error) {
 	today := time.Now()
 	dateStr := today.Format("2006-01-02")
 
 	// Fetch from API for this specific league
 	matches, err := c.MatchesForLeagueAndDate(ctx, leagueID, today, "fixtures")
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	// Filter for live matches only
-	var liveMatches []api.Match
 	for _, match := range matches {
-		// Verify match is for today and is live
-		if match.MatchTime != nil {
-			matchDate := match.MatchTime.UTC().Format("2006-01-02")
-			if matchDate == dateStr && match.Status == api.MatchStatusLive {
-				liveMatches = append(liveMatches, match)
-			}
+		// Verify match is for today
+		if match.MatchTime == nil || match.MatchTime.UTC().Format("2006-01-02") != dateStr {
+			continue
+		}
+		switch match.Status {
+		case api.MatchStatusLive:
+			live = append(live, match)
+		case api.MatchStatusNotStarted, api.MatchStatusPostponed:
+			upcoming = append(upcoming, match)
 		}
 	}
 
-	return liveMatches, nil
+	return live, upcoming, nil
 }
 
 // TotalLeagues returns the number of active leagues (respects user settings).
@@ -91,7 +100,7 @@ func LeagueIDAtIndex(index int) int {
 	return activeLeagues[index]
 }
 
-// LiveUpdateParser parses match events into live update strings.
+// LiveUpdateParser turns match events into timeline entries for the live updates feed.
 type LiveUpdateParser struct{}
 
 // NewLiveUpdateParser creates a new live update parser.
@@ -99,121 +108,144 @@ func NewLiveUpdateParser() *LiveUpdateParser {
 	return &LiveUpdateParser{}
 }
 
-// ParseEvents converts match events into human-readable update strings.
-// Events are sorted by minute in descending order (most recent first).
-func (p *LiveUpdateParser) ParseEvents(events []api.MatchEvent, homeTeam, awayTeam api.Team) []string {
-	// Sort events by minute descending (most recent first)
-	sorted := make([]api.MatchEvent, len(events))
-	copy(sorted, events)
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i].Minute > sorted[j].Minute
-	})
+// ParseEvents converts match events into timeline entries, most recent first.
+// Stoppage time is ordered by the displayed minute (45+3' after 45+1', before 46').
+// Added-time announcements are dropped.
+func (p *LiveUpdateParser) ParseEvents(events []api.MatchEvent, homeTeam, awayTeam api.Team) []api.TimelineEntry {
+	return p.ParseEventsWithDisallowed(events, nil, homeTeam, awayTeam)
+}
 
-	updates := make([]string, 0, len(sorted))
-	for _, event := range sorted {
-		update := p.formatEvent(event, homeTeam, awayTeam)
-		if update != "" {
-			updates = append(updates, update)
+// ParseEventsWithDisallowed is ParseEvents with goals the provider has since removed
+// (see DiffEvents) kept on the timeline, marked Disallowed.
+func (p *LiveUpdateParser) ParseEventsWithDisallowed(events, disallowed []api.MatchEvent, homeTeam, awayTeam api.Team) []api.TimelineEntry {
+	entries := make([]api.TimelineEntry, 0, len(events)+len(disallowed))
+	// Walk newest-first so events sharing a minute keep their most-recent-first order after the stable sort
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Kind == api.EventAddedTime {
+			continue
 		}
+		entries = append(entries, p.timelineEntry(events[i], homeTeam))
+	}
+	for _, event := range disallowed {
+		entry := p.timelineEntry(event, homeTeam)
+		entry.Disallowed = true
+		entries = append(entries, entry)
 	}
 
-	return updates
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].After(entries[j])
+	})
+	return entries
 }
 
-// Event type prefixes for visual identification (used by UI for coloring)
-const (
-	EventPrefixGoal        = "●" // Solid circle - goals (red)
-	EventPrefixYellowCard  = "▪" // Square - yellow card (cyan)
-	EventPrefixRedCard     = "■" // Filled square - red card (red)
-	EventPrefixSubstitution = "↔" // Arrow - substitution (dim)
-	EventPrefixOther       = "·" // Small dot - other events (dim)
-)
-
-// formatEvent formats a single event into a readable string with symbol prefix and label.
-// Format: SYMBOL TIME' [LABEL] details [H] or [A]
-// Symbol prefixes are used by the UI to apply appropriate colors.
-// [H] or [A] suffix indicates home or away team for UI alignment.
-func (p *LiveUpdateParser) formatEvent(event api.MatchEvent, homeTeam, awayTeam api.Team) string {
+// timelineEntry resolves a single event into a timeline entry.
+func (p *LiveUpdateParser) timelineEntry(event api.MatchEvent, homeTeam api.Team) api.TimelineEntry {
 	// Determine if this is a home or away team event
 	isHome := event.Team.ID == homeTeam.ID
 	if event.Team.ID == 0 && event.Team.ShortName != "" {
 		// Fallback to short name matching if ID not set
 		isHome = event.Team.ShortName == homeTeam.ShortName
 	}
-	teamMarker := "[A]"
-	if isHome {
-		teamMarker = "[H]"
-	}
 
-	switch strings.ToLower(event.Type) {
-	case "goal":
-		player := "Unknown"
-		if event.Player != nil {
-			player = *event.Player
-		}
-		return fmt.Sprintf("%s %d' [GOAL] %s %s", EventPrefixGoal, event.Minute, player, teamMarker)
+	entry := api.TimelineEntry{
+		EventID:   event.ID,
+		Kind:      event.Kind,
+		Minute:    event.Minute,
+		AddedTime: addedTime(event.DisplayMinute),
+		Home:      isHome,
+		Detail:    event.Detail,
+	}
+	if event.Player != nil {
+		entry.Player = *event.Player
+	}
+	if event.PlayerIn != nil {
+		entry.PlayerIn = *event.PlayerIn
+	}
+	if event.Assist != nil {
+		entry.Assist = *event.Assist
+	}
+	return entry
+}
 
-	case "card":
-		player := "Unknown"
-		if event.Player != nil {
-			player = *event.Player
-		}
-		cardType := "yellow"
-		if event.EventType != nil {
-			cardType = strings.ToLower(*event.EventType)
-		}
-		prefix := EventPrefixYellowCard
-		if cardType == "red" || cardType == "redcard" || cardType == "secondyellow" {
-			prefix = EventPrefixRedCard
-		}
-		return fmt.Sprintf("%s %d' [CARD] %s %s", prefix, event.Minute, player, teamMarker)
-
-	case "substitution":
-		// Player = player going out, Assist = player coming in (repurposed)
-		playerOut := "Unknown"
-		playerIn := "Unknown"
-		if event.Player != nil && *event.Player != "" {
-			playerOut = *event.Player
-		}
-		if event.Assist != nil && *event.Assist != "" {
-			playerIn = *event.Assist
-		}
-		// Format: show both players - "OUT→ Player | IN← Player"
-		// Using special markers for UI to color-code: {OUT} and {IN}
-		return fmt.Sprintf("%s %d' [SUB] {OUT}%s {IN}%s %s", EventPrefixSubstitution, event.Minute, playerOut, playerIn, teamMarker)
+// addedTime returns the stoppage-time minutes of a displayed minute ("90+4'" -> 4).
+func addedTime(displayMinute string) int {
+	_, added, found := strings.Cut(displayMinute, "+")
+	if !found {
+		return 0
+	}
+	n, _ := strconv.Atoi(strings.TrimSpace(strings.TrimRight(added, "'’ ")))
+	return n
+}
 
-	case "addedtime":
-		// Skip added time events - not useful
-		return ""
+// EventDiff is the difference between two polls of a match's events.
+type EventDiff struct {
+	Added    []api.MatchEvent // Events that appeared since the last poll
+	Removed  []api.MatchEvent // Events that disappeared (e.g., a goal ruled out by VAR), as last seen
+	Modified []api.MatchEvent // Events whose kind, player, team or minute changed, as now reported
+	RuledOut []api.MatchEvent // Goals that were removed or turned into another event, as last seen
+}
 
-	default:
-		player := ""
-		if event.Player != nil {
-			player = *event.Player
-		}
-		if player != "" {
-			return fmt.Sprintf("%s %d' %s %s", EventPrefixOther, event.Minute, player, teamMarker)
-		}
-		return fmt.Sprintf("%s %d' %s %s", EventPrefixOther, event.Minute, event.Type, teamMarker)
-	}
+// Empty reports whether the polls had the same events.
+func (d EventDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Modified) == 0
 }
 
-// NewEvents compares two event lists and returns only new events.
-// This is useful for detecting new updates when polling match details.
-func (p *LiveUpdateParser) NewEvents(oldEvents, newEvents []api.MatchEvent) []api.MatchEvent {
-	// Create a map of old event IDs for quick lookup
-	oldEventMap := make(map[int]bool)
+// DiffEvents compares two polls of a match's events by event ID.
+// Providers correct events in place, so a goal can vanish or turn into another kind between polls.
+func (p *LiveUpdateParser) DiffEvents(oldEvents, newEvents []api.MatchEvent) EventDiff {
+	oldByID := make(map[int]api.MatchEvent, len(oldEvents))
 	for _, event := range oldEvents {
-		oldEventMap[event.ID] = true
+		oldByID[event.ID] = event
 	}
 
-	// Find events that don't exist in old events
-	var newOnly []api.MatchEvent
+	var diff EventDiff
+	seen := make(map[int]bool, len(newEvents))
 	for _, event := range newEvents {
-		if !oldEventMap[event.ID] {
-			newOnly = append(newOnly, event)
+		seen[event.ID] = true
+		old, ok := oldByID[event.ID]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, event)
+		case eventChanged(old, event):
+			diff.Modified = append(diff.Modified, event)
+			if old.Kind.IsGoal() && !event.Kind.IsGoal() {
+				diff.RuledOut = append(diff.RuledOut, old)
+			}
 		}
 	}
+	for _, event := range oldEvents {
+		if !seen[event.ID] {
+			diff.Removed = append(diff.Removed, event)
+			if event.Kind.IsGoal() {
+				diff.RuledOut = append(diff.RuledOut, event)
+			}
+		}
+	}
+	return diff
+}
+
+// eventChanged reports whether a provider correction changed what an event says.
+func eventChanged(old, event api.MatchEvent) bool {
+	return old.Kind != event.Kind ||
+		old.Minute != event.Minute ||
+		old.DisplayMinute != event.DisplayMinute ||
+		old.Team.ID != event.Team.ID ||
+		old.Team.ShortName != event.Team.ShortName ||
+		optionalString(old.Player) != optionalString(event.Player) ||
+		optionalString(old.PlayerIn) != optionalString(event.PlayerIn) ||
+		optionalString(old.Assist) != optionalString(event.Assist)
+}
+
+// optionalString returns the string s points to, or "" for nil.
+func optionalString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
 
-	return newOnly
+// NewEvents compares two event lists and returns only new events.
+// This is useful for detecting new updates when polling match details.
+func (p *LiveUpdateParser) NewEvents(oldEvents, newEvents []api.MatchEvent) []api.MatchEvent {
+	return p.DiffEvents(oldEvents, newEvents).Added
 }
@@ -0,0 +1,80 @@
+package fotmob
+
+import (
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestParseEventsOrdering(t *testing.T) {
+	home := api.Team{ID: 1, ShortName: "HOM"}
+	away := api.Team{ID: 2, ShortName: "AWY"}
+	name := func(s string) *string { return &s }
+
+	events := []api.MatchEvent{
+		{ID: 1, Minute: 45, DisplayMinute: "45+1'", Kind: api.EventYellowCard, Team: home, Player: name("Early")},
+		{ID: 2, Minute: 45, DisplayMinute: "45+3'", Kind: api.EventGoal, Team: away, Player: name("Late [C]")},
+		{ID: 3, Minute: 45, DisplayMinute: "45+3'", Kind: api.EventAddedTime, Detail: "3"},
+		{ID: 4, Minute: 46, DisplayMinute: "46'", Kind: api.EventSubstitution, Team: home, Player: name("Off"), PlayerIn: name("On")},
+		{ID: 5, Minute: 45, DisplayMinute: "45+3'", Kind: api.EventRedCard, Team: home, Player: name("Same minute")},
+	}
+
+	entries := NewLiveUpdateParser().ParseEvents(events, home, away)
+
+	wantIDs := []int{4, 5, 2, 1}
+	if len(entries) != len(wantIDs) {
+		t.Fatalf("ParseEvents() returned %d entries; want %d", len(entries), len(wantIDs))
+	}
+	for i, id := range wantIDs {
+		if entries[i].EventID != id {
+			t.Errorf("entry %d: EventID = %d; want %d", i, entries[i].EventID, id)
+		}
+	}
+
+	goal := entries[2]
+	if goal.Home || goal.Player != "Late [C]" || goal.MinuteLabel() != "45+3'" {
+		t.Errorf("goal entry = %+v; want away goal by %q at 45+3'", goal, "Late [C]")
+	}
+	if sub := entries[0]; sub.Player != "Off" || sub.PlayerIn != "On" {
+		t.Errorf("substitution entry = %+v; want Off -> On", sub)
+	}
+}
+
+func TestDiffEvents(t *testing.T) {
+	home := api.Team{ID: 1, ShortName: "HOM"}
+	away := api.Team{ID: 2, ShortName: "AWY"}
+	name := func(s string) *string { return &s }
+
+	oldEvents := []api.MatchEvent{
+		{ID: 1, Minute: 12, Kind: api.EventGoal, Team: home, Player: name("Scorer")},
+		{ID: 2, Minute: 30, Kind: api.EventYellowCard, Team: away, Player: name("Booked")},
+		{ID: 3, Minute: 55, Kind: api.EventGoal, Team: away, Player: name("Offside")},
+	}
+	newEvents := []api.MatchEvent{
+		{ID: 1, Minute: 12, Kind: api.EventOwnGoal, Team: home, Player: name("Defender")},
+		{ID: 2, Minute: 30, Kind: api.EventYellowCard, Team: away, Player: name("Booked")},
+		{ID: 4, Minute: 56, Kind: api.EventVAR, Team: away, Detail: "Goal cancelled"},
+	}
+
+	diff := NewLiveUpdateParser().DiffEvents(oldEvents, newEvents)
+
+	if len(diff.Added) != 1 || diff.Added[0].ID != 4 {
+		t.Errorf("Added = %+v; want event 4", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].ID != 3 || *diff.Removed[0].Player != "Offside" {
+		t.Errorf("Removed = %+v; want event 3 as last seen", diff.Removed)
+	}
+	if len(diff.Modified) != 1 || diff.Modified[0].Kind != api.EventOwnGoal {
+		t.Errorf("Modified = %+v; want event 1 as an own goal", diff.Modified)
+	}
+
+	// Event 1 is still a goal (credited as an own goal), so only event 3 is ruled out
+	if len(diff.RuledOut) != 1 || diff.RuledOut[0].ID != 3 {
+		t.Errorf("RuledOut = %+v; want event 3", diff.RuledOut)
+	}
+
+	entries := NewLiveUpdateParser().ParseEventsWithDisallowed(newEvents, diff.Removed, home, away)
+	if len(entries) != 4 || entries[1].EventID != 3 || !entries[1].Disallowed {
+		t.Errorf("ParseEventsWithDisallowed() = %+v; want disallowed event 3 second", entries)
+	}
+}
@@ -0,0 +1,269 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// maxPlayerRecentMatches limits how many recent match ratings are kept for the sparkline.
+const maxPlayerRecentMatches = 15
+
+// fotmobPlayerResponse represents the FotMob /playerData response (only the parts we use).
+type fotmobPlayerResponse struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	PrimaryTeam struct {
+		TeamID   int    `json:"teamId"`
+		TeamName string `json:"teamName"`
+	} `json:"primaryTeam"`
+	PositionDescription *fotmobPositionDescription `json:"positionDescription"`
+	Origin              struct {
+		PositionDesc *fotmobPositionDescription `json:"positionDesc"`
+	} `json:"origin"`
+	PlayerInformation []struct {
+		Title string `json:"title"`
+		Value struct {
+			Fallback    json.RawMessage `json:"fallback"`
+			NumberValue json.RawMessage `json:"numberValue"`
+		} `json:"value"`
+	} `json:"playerInformation"`
+	MainLeague *struct {
+		LeagueID   int    `json:"leagueId"`
+		LeagueName string `json:"leagueName"`
+		Season     string `json:"season"`
+		Stats      []struct {
+			Title string          `json:"title"`
+			Value json.RawMessage `json:"value"`
+		} `json:"stats"`
+	} `json:"mainLeague"`
+	RecentMatches []struct {
+		ID               json.RawMessage `json:"id"`
+		OpponentTeamName string          `json:"opponentTeamName"`
+		LeagueName       string          `json:"leagueName"`
+		MatchDate        struct {
+			UTCTime string `json:"utcTime"`
+		} `json:"matchDate"`
+		MinutesPlayed json.RawMessage `json:"minutesPlayed"`
+		Goals         json.RawMessage `json:"goals"`
+		Assists       json.RawMessage `json:"assists"`
+		RatingProps   struct {
+			Num    json.RawMessage `json:"num"`
+			Rating json.RawMessage `json:"rating"`
+		} `json:"ratingProps"`
+	} `json:"recentMatches"`
+	CareerHistory struct {
+		CareerItems map[string]fotmobCareerSection `json:"careerItems"`
+	} `json:"careerHistory"`
+}
+
+// fotmobPositionDescription holds the player's primary position label.
+type fotmobPositionDescription struct {
+	PrimaryPosition struct {
+		Label string `json:"label"`
+	} `json:"primaryPosition"`
+}
+
+// fotmobCareerSection is one section of career history ("senior", "national team", ...).
+type fotmobCareerSection struct {
+	TeamEntries []struct {
+		Team        string          `json:"team"`
+		TeamID      int             `json:"teamId"`
+		StartDate   string          `json:"startDate"`
+		EndDate     string          `json:"endDate"`
+		Active      bool            `json:"active"`
+		Appearances json.RawMessage `json:"appearances"`
+		Goals       json.RawMessage `json:"goals"`
+	} `json:"teamEntries"`
+	SeasonEntries []struct {
+		SeasonName      string `json:"seasonName"`
+		TournamentStats []struct {
+			LeagueID    int             `json:"leagueId"`
+			LeagueName  string          `json:"leagueName"`
+			Appearances json.RawMessage `json:"appearances"`
+			Goals       json.RawMessage `json:"goals"`
+			Assists     json.RawMessage `json:"assists"`
+			Rating      struct {
+				Rating json.RawMessage `json:"rating"`
+			} `json:"rating"`
+		} `json:"tournamentStats"`
+	} `json:"seasonEntries"`
+}
+
+// Player retrieves a player's profile, season statistics, recent ratings and career history.
+func (c *Client) Player(ctx context.Context, playerID int) (*api.Player, error) {
+	// Apply rate limiting
+	c.rateLimiter.Wait()
+
+	url := fmt.Sprintf("%s/playerData?id=%d", c.baseURL, playerID)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request for player %d: %w", playerID, err)
+	}
+
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch player %d: %w", playerID, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for player %d", resp.StatusCode, playerID)
+	}
+
+	var response fotmobPlayerResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode player %d response: %w", playerID, err)
+	}
+
+	return response.toAPIPlayer(), nil
+}
+
+// toAPIPlayer converts the FotMob player response to api.Player.
+func (r fotmobPlayerResponse) toAPIPlayer() *api.Player {
+	player := &api.Player{
+		ID:   r.ID,
+		Name: r.Name,
+		Team: api.Team{
+			ID:        r.PrimaryTeam.TeamID,
+			Name:      r.PrimaryTeam.TeamName,
+			ShortName: r.PrimaryTeam.TeamName,
+		},
+	}
+
+	// Position moved between response versions
+	if r.PositionDescription != nil {
+		player.Position = r.PositionDescription.PrimaryPosition.Label
+	} else if r.Origin.PositionDesc != nil {
+		player.Position = r.Origin.PositionDesc.PrimaryPosition.Label
+	}
+
+	for _, info := range r.PlayerInformation {
+		switch strings.ToLower(info.Title) {
+		case "country":
+			player.Nationality = parseRawString(info.Value.Fallback)
+		case "age":
+			player.Age = int(parseRawFloat(info.Value.NumberValue))
+		case "shirt":
+			player.Number = int(parseRawFloat(info.Value.NumberValue))
+		case "height":
+			player.Height = parseRawString(info.Value.Fallback)
+		}
+	}
+
+	senior := r.CareerHistory.CareerItems["senior"]
+
+	// Per-competition stats for the current season, falling back to the main league summary
+	if len(senior.SeasonEntries) > 0 {
+		season := senior.SeasonEntries[0]
+		for _, t := range season.TournamentStats {
+			player.SeasonStats = append(player.SeasonStats, api.PlayerSeasonStats{
+				LeagueID:    t.LeagueID,
+				LeagueName:  t.LeagueName,
+				Season:      season.SeasonName,
+				Appearances: int(parseRawFloat(t.Appearances)),
+				Goals:       int(parseRawFloat(t.Goals)),
+				Assists:     int(parseRawFloat(t.Assists)),
+				Rating:      parseRawFloat(t.Rating.Rating),
+			})
+		}
+	}
+	if len(player.SeasonStats) == 0 && r.MainLeague != nil {
+		stats := api.PlayerSeasonStats{
+			LeagueID:   r.MainLeague.LeagueID,
+			LeagueName: r.MainLeague.LeagueName,
+			Season:     r.MainLeague.Season,
+		}
+		for _, s := range r.MainLeague.Stats {
+			value := parseRawFloat(s.Value)
+			switch strings.ToLower(s.Title) {
+			case "matches":
+				stats.Appearances = int(value)
+			case "goals":
+				stats.Goals = int(value)
+			case "assists":
+				stats.Assists = int(value)
+			case "minutes played":
+				stats.Minutes = int(value)
+			case "rating":
+				stats.Rating = value
+			}
+		}
+		player.SeasonStats = append(player.SeasonStats, stats)
+	}
+
+	for _, m := range r.RecentMatches {
+		rating := parseRawFloat(m.RatingProps.Num)
+		if rating == 0 {
+			rating = parseRawFloat(m.RatingProps.Rating)
+		}
+		player.RecentMatches = append(player.RecentMatches, api.PlayerMatchRating{
+			MatchID:    parseRawID(m.ID),
+			Opponent:   m.OpponentTeamName,
+			LeagueName: m.LeagueName,
+			MatchTime:  parseTimeOrNil(m.MatchDate.UTCTime),
+			Rating:     rating,
+			Minutes:    int(parseRawFloat(m.MinutesPlayed)),
+			Goals:      int(parseRawFloat(m.Goals)),
+			Assists:    int(parseRawFloat(m.Assists)),
+		})
+		if len(player.RecentMatches) >= maxPlayerRecentMatches {
+			break
+		}
+	}
+
+	// Club career first, then national team spells
+	for _, section := range []string{"senior", "national team"} {
+		for _, e := range r.CareerHistory.CareerItems[section].TeamEntries {
+			entry := api.PlayerCareerEntry{
+				TeamID:      e.TeamID,
+				TeamName:    e.Team,
+				Appearances: int(parseRawFloat(e.Appearances)),
+				Goals:       int(parseRawFloat(e.Goals)),
+				Active:      e.Active,
+			}
+			if t := parseTimeOrNil(e.StartDate); t != nil {
+				entry.StartYear = t.Year()
+			}
+			if t := parseTimeOrNil(e.EndDate); t != nil {
+				entry.EndYear = t.Year()
+			}
+			player.Career = append(player.Career, entry)
+		}
+	}
+
+	return player
+}
+
+// parseRawFloat parses a number that FotMob may encode as a number, a string or null.
+func parseRawFloat(raw json.RawMessage) float64 {
+	if len(raw) == 0 {
+		return 0
+	}
+	var f float64
+	if err := json.Unmarshal(raw, &f); err == nil {
+		return f
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		f, _ = strconv.ParseFloat(strings.TrimSpace(s), 64)
+	}
+	return f
+}
+
+// parseRawString parses a string value, returning "" for null or non-string values.
+func parseRawString(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return ""
+	}
+	return s
+}
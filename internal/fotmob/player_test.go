@@ -0,0 +1,132 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestToAPIPlayer(t *testing.T) {
+	raw := `{
+		"id": 961995, "name": "Bukayo Saka",
+		"primaryTeam": {"teamId": 9825, "teamName": "Arsenal"},
+		"origin": {"positionDesc": {"primaryPosition": {"label": "Right Winger"}}},
+		"playerInformation": [
+			{"title": "Country", "value": {"fallback": "England"}},
+			{"title": "Age", "value": {"numberValue": 24}},
+			{"title": "Shirt", "value": {"numberValue": "7"}},
+			{"title": "Height", "value": {"fallback": "178 cm"}},
+			{"title": "Preferred foot", "value": {"fallback": "Left"}}
+		],
+		"mainLeague": {"leagueId": 47, "leagueName": "Premier League", "season": "2025/2026", "stats": [{"title": "Goals", "value": 99}]},
+		"recentMatches": [
+			{"id": "1", "opponentTeamName": "Liverpool", "leagueName": "Premier League", "matchDate": {"utcTime": "2026-03-01T16:30:00Z"},
+				"minutesPlayed": 90, "goals": 1, "assists": "2", "ratingProps": {"num": "8.4"}},
+			{"id": 2, "opponentTeamName": "Man City", "ratingProps": {"rating": 6.5}}
+		],
+		"careerHistory": {"careerItems": {
+			"senior": {
+				"teamEntries": [{"team": "Arsenal", "teamId": 9825, "startDate": "2018-07-01T00:00:00Z", "active": true, "appearances": "250", "goals": 70}],
+				"seasonEntries": [{"seasonName": "2025/2026", "tournamentStats": [
+					{"leagueId": 47, "leagueName": "Premier League", "appearances": 28, "goals": 12, "assists": 9, "rating": {"rating": "7.62"}},
+					{"leagueId": 42, "leagueName": "Champions League", "appearances": "8", "goals": 3, "rating": {"rating": null}}
+				]}]
+			},
+			"national team": {"teamEntries": [{"team": "England", "teamId": 8491, "startDate": "2020-10-08T00:00:00Z", "appearances": 45, "goals": 12}]}
+		}}
+	}`
+
+	var response fotmobPlayerResponse
+	if err := json.Unmarshal([]byte(raw), &response); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	got := response.toAPIPlayer()
+
+	if got.ID != 961995 || got.Name != "Bukayo Saka" || got.Position != "Right Winger" {
+		t.Errorf("player = %d %q %q; want 961995 %q %q", got.ID, got.Name, got.Position, "Bukayo Saka", "Right Winger")
+	}
+	if want := (api.Team{ID: 9825, Name: "Arsenal", ShortName: "Arsenal"}); got.Team != want {
+		t.Errorf("Team = %+v; want %+v", got.Team, want)
+	}
+	if got.Nationality != "England" || got.Age != 24 || got.Number != 7 || got.Height != "178 cm" {
+		t.Errorf("info = %q, %d, %d, %q; want England, 24, 7, 178 cm", got.Nationality, got.Age, got.Number, got.Height)
+	}
+
+	// The season entries win over the main league summary
+	wantStats := []api.PlayerSeasonStats{
+		{LeagueID: 47, LeagueName: "Premier League", Season: "2025/2026", Appearances: 28, Goals: 12, Assists: 9, Rating: 7.62},
+		{LeagueID: 42, LeagueName: "Champions League", Season: "2025/2026", Appearances: 8, Goals: 3},
+	}
+	if len(got.SeasonStats) != len(wantStats) {
+		t.Fatalf("SeasonStats = %+v; want %+v", got.SeasonStats, wantStats)
+	}
+	for i := range wantStats {
+		if got.SeasonStats[i] != wantStats[i] {
+			t.Errorf("SeasonStats[%d] = %+v; want %+v", i, got.SeasonStats[i], wantStats[i])
+		}
+	}
+
+	if len(got.RecentMatches) != 2 {
+		t.Fatalf("RecentMatches = %+v; want 2 matches", got.RecentMatches)
+	}
+	if m := got.RecentMatches[0]; m.MatchID != 1 || m.Rating != 8.4 || m.Minutes != 90 || m.Goals != 1 || m.Assists != 2 || m.MatchTime == nil {
+		t.Errorf("RecentMatches[0] = %+v; want match 1 rated 8.4, 90 minutes, 1 goal, 2 assists", m)
+	}
+	if m := got.RecentMatches[1]; m.MatchID != 2 || m.Rating != 6.5 || m.MatchTime != nil {
+		t.Errorf("RecentMatches[1] = %+v; want match 2 rated 6.5 without a date", m)
+	}
+
+	wantCareer := []api.PlayerCareerEntry{
+		{TeamID: 9825, TeamName: "Arsenal", StartYear: 2018, Appearances: 250, Goals: 70, Active: true},
+		{TeamID: 8491, TeamName: "England", StartYear: 2020, Appearances: 45, Goals: 12},
+	}
+	if len(got.Career) != len(wantCareer) {
+		t.Fatalf("Career = %+v; want %+v", got.Career, wantCareer)
+	}
+	for i := range wantCareer {
+		if got.Career[i] != wantCareer[i] {
+			t.Errorf("Career[%d] = %+v; want %+v", i, got.Career[i], wantCareer[i])
+		}
+	}
+}
+
+func TestToAPIPlayerMissingFields(t *testing.T) {
+	tests := []struct {
+		desc      string
+		raw       string
+		wantStats []api.PlayerSeasonStats
+	}{
+		{"empty", `{}`, nil},
+		{"nulls", `{"positionDescription": null, "mainLeague": null, "playerInformation": null, "careerHistory": {"careerItems": null}}`, nil},
+		{"unparseable values", `{"playerInformation": [{"title": "Age", "value": {"numberValue": "n/a"}}, {"title": "Country", "value": {"fallback": 1}}]}`, nil},
+		{
+			"main league only",
+			`{"mainLeague": {"leagueId": 47, "leagueName": "Premier League", "stats": [{"title": "Matches", "value": "20"}, {"title": "Rating", "value": 7.1}, {"title": "Minutes played", "value": null}]}}`,
+			[]api.PlayerSeasonStats{{LeagueID: 47, LeagueName: "Premier League", Appearances: 20, Rating: 7.1}},
+		},
+	}
+
+	for _, tt := range tests {
+		var response fotmobPlayerResponse
+		if err := json.Unmarshal([]byte(tt.raw), &response); err != nil {
+			t.Fatalf("%s: unmarshal: %v", tt.desc, err)
+		}
+		got := response.toAPIPlayer()
+		if got == nil {
+			t.Fatalf("%s: toAPIPlayer() = nil", tt.desc)
+		}
+		if got.Position != "" || got.Nationality != "" || got.Age != 0 {
+			t.Errorf("%s: info = %q, %q, %d; want empty", tt.desc, got.Position, got.Nationality, got.Age)
+		}
+		if len(got.SeasonStats) != len(tt.wantStats) {
+			t.Errorf("%s: SeasonStats = %+v; want %+v", tt.desc, got.SeasonStats, tt.wantStats)
+			continue
+		}
+		for i := range tt.wantStats {
+			if got.SeasonStats[i] != tt.wantStats[i] {
+				t.Errorf("%s: SeasonStats[%d] = %+v; want %+v", tt.desc, i, got.SeasonStats[i], tt.wantStats[i])
+			}
+		}
+	}
+}
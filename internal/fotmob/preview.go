@@ -0,0 +1,190 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// maxHeadToHeadMatches limits how many previous meetings are kept for the preview panel.
+const maxHeadToHeadMatches = 5
+
+// fotmobH2H represents the head-to-head block of FotMob match details.
+// Summary is [home wins, draws, away wins] relative to the current fixture.
+type fotmobH2H struct {
+	Summary []int            `json:"summary"`
+	Matches []fotmobH2HMatch `json:"matches"`
+}
+
+// fotmobH2HMatch represents a single previous meeting between the two teams.
+type fotmobH2HMatch struct {
+	Time struct {
+		UTCTime string `json:"utcTime"`
+	} `json:"time"`
+	League struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"league"`
+	Home   fotmobFormTeam `json:"home"`
+	Away   fotmobFormTeam `json:"away"`
+	Status struct {
+		ScoreStr string `json:"scoreStr"` // e.g., "2 - 1"
+		Finished bool   `json:"finished"`
+	} `json:"status"`
+}
+
+// fotmobFormTeam represents a team reference in form and head-to-head entries.
+// FotMob is inconsistent about ID types here, so the ID is kept raw.
+type fotmobFormTeam struct {
+	ID        json.RawMessage `json:"id"`
+	Name      string          `json:"name"`
+	IsOurTeam bool            `json:"isOurTeam"`
+}
+
+// fotmobFormResult represents one entry in a team's recent form.
+type fotmobFormResult struct {
+	ResultString string `json:"resultString"` // "W", "D" or "L"
+	Score        string `json:"score"`        // e.g., "2-1"
+	Date         struct {
+		UTCTime string `json:"utcTime"`
+	} `json:"date"`
+	Home fotmobFormTeam `json:"home"`
+	Away fotmobFormTeam `json:"away"`
+}
+
+// parseHeadToHead converts FotMob's head-to-head block into api.HeadToHead.
+// Returns nil if the block is missing or malformed - it is optional pre-match context.
+func parseHeadToHead(raw json.RawMessage) *api.HeadToHead {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var h2h fotmobH2H
+	if err := json.Unmarshal(raw, &h2h); err != nil {
+		return nil
+	}
+	if len(h2h.Summary) < 3 && len(h2h.Matches) == 0 {
+		return nil
+	}
+
+	result := &api.HeadToHead{}
+	if len(h2h.Summary) >= 3 {
+		result.HomeWins = h2h.Summary[0]
+		result.Draws = h2h.Summary[1]
+		result.AwayWins = h2h.Summary[2]
+	}
+
+	for _, m := range h2h.Matches {
+		if !m.Status.Finished {
+			continue
+		}
+
+		match := api.Match{
+			League:   api.League{ID: m.League.ID, Name: m.League.Name},
+			HomeTeam: api.Team{ID: parseRawID(m.Home.ID), Name: m.Home.Name, ShortName: m.Home.Name},
+			AwayTeam: api.Team{ID: parseRawID(m.Away.ID), Name: m.Away.Name, ShortName: m.Away.Name},
+			Status:   api.MatchStatusFinished,
+			State:    api.MatchState{Phase: api.PhaseFullTime},
+		}
+		if t := parseTimeOrNil(m.Time.UTCTime); t != nil {
+			match.MatchTime = t
+		}
+		if home, away, ok := parseScoreString(m.Status.ScoreStr); ok {
+			match.HomeScore = &home
+			match.AwayScore = &away
+		}
+
+		result.Matches = append(result.Matches, match)
+		if len(result.Matches) >= maxHeadToHeadMatches {
+			break
+		}
+	}
+
+	return result
+}
+
+// parseTeamForm converts FotMob's teamForm block ([[home], [away]]) into form results.
+// Results are returned most recent first. Missing or malformed data yields nil slices.
+func parseTeamForm(raw json.RawMessage) (home, away []api.FormResult) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var form [][]fotmobFormResult
+	if err := json.Unmarshal(raw, &form); err != nil {
+		return nil, nil
+	}
+
+	convert := func(entries []fotmobFormResult) []api.FormResult {
+		results := make([]api.FormResult, 0, len(entries))
+		for _, e := range entries {
+			r := api.FormResult{
+				Result:    strings.ToUpper(e.ResultString),
+				Score:     e.Score,
+				MatchTime: parseTimeOrNil(e.Date.UTCTime),
+			}
+			// The opponent is whichever side isn't "our team"
+			if e.Home.IsOurTeam {
+				r.Opponent = e.Away.Name
+				r.IsHome = true
+			} else {
+				r.Opponent = e.Home.Name
+			}
+			results = append(results, r)
+		}
+		// FotMob lists form oldest first; the UI wants most recent first
+		for i, j := 0, len(results)-1; i < j; i, j = i+1, j-1 {
+			results[i], results[j] = results[j], results[i]
+		}
+		return results
+	}
+
+	if len(form) > 0 {
+		home = convert(form[0])
+	}
+	if len(form) > 1 {
+		away = convert(form[1])
+	}
+	return home, away
+}
+
+// parseScoreString parses scores like "2 - 1" or "2-1". ok is false unless both sides are numbers.
+func parseScoreString(s string) (home, away int, ok bool) {
+	parts := strings.Split(strings.ReplaceAll(s, " ", ""), "-")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return 0, 0, false
+	}
+	home, homeErr := strconv.Atoi(parts[0])
+	away, awayErr := strconv.Atoi(parts[1])
+	if homeErr != nil || awayErr != nil {
+		return 0, 0, false
+	}
+	return home, away, true
+}
+
+// parseRawID parses an ID that FotMob may encode as either a number or a string.
+func parseRawID(raw json.RawMessage) int {
+	if len(raw) == 0 {
+		return 0
+	}
+	var n int
+	if err := json.Unmarshal(raw, &n); err == nil {
+		return n
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return parseInt(s)
+	}
+	return 0
+}
+
+// parseTimeOrNil parses a FotMob timestamp, returning nil for empty strings.
+func parseTimeOrNil(s string) *time.Time {
+	if s == "" {
+		return nil
+	}
+	return parseTime(s)
+}
@@ -0,0 +1,91 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// maxTeamSearchResults caps the teams returned by a search.
+const maxTeamSearchResults = 20
+
+// fotmobSearchGroup is one group of the search suggestions response (teams, players, leagues...).
+type fotmobSearchGroup struct {
+	Suggestions []fotmobSearchSuggestion `json:"suggestions"`
+}
+
+// fotmobSearchSuggestion is one search suggestion. Only team suggestions are used.
+type fotmobSearchSuggestion struct {
+	Type       string          `json:"type"`
+	ID         json.RawMessage `json:"id"` // string or number
+	Name       string          `json:"name"`
+	LeagueID   json.RawMessage `json:"leagueId"` // string or number
+	LeagueName string          `json:"leagueName"`
+}
+
+// SearchTeams finds teams by name, best matches first.
+// Teams from any league are found, not only the followed ones.
+func (c *Client) SearchTeams(ctx context.Context, query string) ([]api.TeamSearchResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return []api.TeamSearchResult{}, nil
+	}
+
+	// Apply rate limiting
+	c.rateLimiter.Wait()
+
+	requestURL := fmt.Sprintf("%s/search/suggest?term=%s&lang=en", c.baseURL, url.QueryEscape(query))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create search request for %q: %w", query, err)
+	}
+
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("search teams for %q: %w", query, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for search %q", resp.StatusCode, query)
+	}
+
+	var groups []fotmobSearchGroup
+	if err := json.NewDecoder(resp.Body).Decode(&groups); err != nil {
+		return nil, fmt.Errorf("decode search %q response: %w", query, err)
+	}
+
+	return toAPITeamSearchResults(groups), nil
+}
+
+// toAPITeamSearchResults collects the team suggestions across groups, skipping duplicates.
+func toAPITeamSearchResults(groups []fotmobSearchGroup) []api.TeamSearchResult {
+	results := []api.TeamSearchResult{}
+	seen := make(map[int]bool)
+	for _, group := range groups {
+		for _, s := range group.Suggestions {
+			id := parseRawID(s.ID)
+			if s.Type != "team" || id == 0 || seen[id] {
+				continue
+			}
+			seen[id] = true
+			results = append(results, api.TeamSearchResult{
+				Team:     api.Team{ID: id, Name: s.Name, ShortName: s.Name},
+				LeagueID: parseRawID(s.LeagueID),
+				League:   s.LeagueName,
+			})
+			if len(results) == maxTeamSearchResults {
+				return results
+			}
+		}
+	}
+	return results
+}
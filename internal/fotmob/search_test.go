@@ -0,0 +1,41 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestToAPITeamSearchResults(t *testing.T) {
+	raw := `[
+		{"title": {"key": "teams"}, "suggestions": [
+			{"type": "team", "id": "9825", "name": "Arsenal", "leagueId": 47, "leagueName": "Premier League"},
+			{"type": "team", "id": 258657, "name": "Arsenal Women", "leagueName": "Women's Super League"},
+			{"type": "team", "id": "", "name": "No ID"}
+		]},
+		{"title": {"key": "players"}, "suggestions": [
+			{"type": "player", "id": "1", "name": "Arsenal Fan", "teamName": "Arsenal"},
+			{"type": "team", "id": "9825", "name": "Arsenal", "leagueName": "Premier League"}
+		]}
+	]`
+
+	var groups []fotmobSearchGroup
+	if err := json.Unmarshal([]byte(raw), &groups); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	got := toAPITeamSearchResults(groups)
+
+	want := []api.TeamSearchResult{
+		{Team: api.Team{ID: 9825, Name: "Arsenal", ShortName: "Arsenal"}, LeagueID: 47, League: "Premier League"},
+		{Team: api.Team{ID: 258657, Name: "Arsenal Women", ShortName: "Arsenal Women"}, League: "Women's Super League"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("toAPITeamSearchResults() returned %d results; want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("result %d = %+v; want %+v", i, got[i], want[i])
+		}
+	}
+}
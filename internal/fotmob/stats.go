@@ -80,7 +80,7 @@ func (c *Client) StatsData(ctx context.Context) (*StatsData, error) {
 				if isToday {
 					todayFinishedMap[match.ID] = match
 				}
-			} else if match.Status == api.MatchStatusNotStarted && isToday {
+			} else if (match.Status == api.MatchStatusNotStarted || match.Status == api.MatchStatusPostponed) && isToday {
 				// Only today has upcoming matches
 				todayUpcomingMap[match.ID] = match
 			}
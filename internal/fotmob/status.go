@@ -0,0 +1,209 @@
+package fotmob
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// fotmobReason is the reason attached to a match status, e.g. {"short": "AET", "shortKey": "after_extra_time_short"}.
+type fotmobReason struct {
+	Short    string `json:"short"`
+	ShortKey string `json:"shortKey"`
+	Long     string `json:"long"`
+}
+
+// text returns the reason fields joined for matching.
+func (r *fotmobReason) text() string {
+	if r == nil {
+		return ""
+	}
+	return r.Short + " " + r.ShortKey + " " + r.Long
+}
+
+// Reason vocabulary: exact abbreviations and substrings of the longer keys/descriptions.
+var (
+	reasonPostponed   = reasonTerms{abbr: []string{"pp", "ppd", "pst"}, words: []string{"postpone"}}
+	reasonAbandoned   = reasonTerms{abbr: []string{"ab", "abd", "abn"}, words: []string{"abandon"}}
+	reasonCancelled   = reasonTerms{abbr: []string{"can", "canc"}, words: []string{"cancel"}}
+	reasonSuspended   = reasonTerms{abbr: []string{"susp"}, words: []string{"suspend"}}
+	reasonInterrupted = reasonTerms{abbr: []string{"int"}, words: []string{"interrupt"}}
+	reasonHalfTime    = reasonTerms{abbr: []string{"ht"}, words: []string{"halftime", "half_time", "half time"}}
+
+	// The break before extra time and the one between its halves; checked before half-time,
+	// as "ET HT" and "extra time half-time" name both
+	reasonExtraTimeBreak = reasonTerms{
+		abbr:  []string{"etht", "et-ht", "bet"},
+		words: []string{"et ht", "extra_time_break", "extra time break", "break before extra", "extra_time_half", "extra time half"},
+	}
+	reasonPenalties = reasonTerms{abbr: []string{"pen", "pens", "ap"}, words: []string{"penalt"}}
+	reasonExtraTime = reasonTerms{abbr: []string{"aet"}, words: []string{"extra_time", "extra time"}}
+)
+
+// reasonTerms matches a status reason by abbreviation or keyword.
+type reasonTerms struct {
+	abbr  []string
+	words []string
+}
+
+// in reports whether text (lowercased) contains one of the terms.
+func (t reasonTerms) in(text string) bool {
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool { return r == ' ' || r == '.' }) {
+		for _, abbr := range t.abbr {
+			if field == abbr {
+				return true
+			}
+		}
+	}
+	for _, word := range t.words {
+		if strings.Contains(text, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseMatchState derives a match state from FotMob's status flags, status reason
+// (short, key or long text, e.g. "AET") and live clock (e.g., "45+2’", "HT").
+func ParseMatchState(started, finished, cancelled bool, reason, clock string) api.MatchState {
+	text := strings.ToLower(reason + " " + clock)
+
+	switch {
+	case reasonPostponed.in(text):
+		return api.MatchState{Phase: api.PhasePostponed}
+	case reasonAbandoned.in(text):
+		return api.MatchState{Phase: api.PhaseAbandoned}
+	case cancelled || reasonCancelled.in(text):
+		return api.MatchState{Phase: api.PhaseCancelled}
+	case finished:
+		switch {
+		case reasonPenalties.in(text):
+			return api.MatchState{Phase: api.PhaseAfterPenalties}
+		case reasonExtraTime.in(text):
+			return api.MatchState{Phase: api.PhaseAfterExtraTime}
+		default:
+			return api.MatchState{Phase: api.PhaseFullTime}
+		}
+	case started:
+		switch {
+		case reasonSuspended.in(text):
+			return api.MatchState{Phase: api.PhaseSuspended}
+		case reasonInterrupted.in(text):
+			return api.MatchState{Phase: api.PhaseInterrupted}
+		case reasonExtraTimeBreak.in(text):
+			return api.MatchState{Phase: api.PhaseExtraTimeBreak}
+		case reasonHalfTime.in(text):
+			return api.MatchState{Phase: api.PhaseHalfTime}
+		case reasonPenalties.in(text):
+			return api.MatchState{Phase: api.PhasePenalties}
+		default:
+			return clockState(clock)
+		}
+	default:
+		return api.MatchState{Phase: api.PhasePreMatch}
+	}
+}
+
+// clockState parses a running clock such as "67’" or "90+3'" into a state,
+// inferring the period from the minute. An unreadable clock gives a first-half state with no minute.
+func clockState(clock string) api.MatchState {
+	clock = strings.TrimSpace(strings.NewReplacer("’", "", "'", "", " ", "").Replace(clock))
+	base, added, _ := strings.Cut(clock, "+")
+
+	state := api.MatchState{Phase: api.PhaseFirstHalf}
+	state.Minute, _ = strconv.Atoi(base)
+	state.AddedTime, _ = strconv.Atoi(added)
+
+	switch {
+	case state.Minute > 105:
+		state.Phase = api.PhaseExtraTimeSecond
+	case state.Minute > 90:
+		state.Phase = api.PhaseExtraTimeFirst
+	case state.Minute > 45:
+		state.Phase = api.PhaseSecondHalf
+	}
+	return state
+}
+
+// fotmobHalfs holds the kickoff time of each period, e.g. "14.09.2024 17:03:27" (UTC).
+type fotmobHalfs struct {
+	FirstHalfStarted       string `json:"firstHalfStarted"`
+	SecondHalfStarted      string `json:"secondHalfStarted"`
+	FirstExtraHalfStarted  string `json:"firstExtraHalfStarted"`
+	SecondExtraHalfStarted string `json:"secondExtraHalfStarted"`
+}
+
+// halfsTimeLayout is the format of the period kickoff times.
+const halfsTimeLayout = "02.01.2006 15:04:05"
+
+// started returns the kickoff time of the period the phase is in.
+func (h *fotmobHalfs) started(phase api.MatchPhase) (time.Time, bool) {
+	if h == nil {
+		return time.Time{}, false
+	}
+	var value string
+	switch phase {
+	case api.PhaseFirstHalf:
+		value = h.FirstHalfStarted
+	case api.PhaseSecondHalf:
+		value = h.SecondHalfStarted
+	case api.PhaseExtraTimeFirst:
+		value = h.FirstExtraHalfStarted
+	case api.PhaseExtraTimeSecond:
+		value = h.SecondExtraHalfStarted
+	}
+	t, err := time.Parse(halfsTimeLayout, strings.TrimSpace(value))
+	return t, err == nil
+}
+
+// periodStart returns when the current period of a running match kicked off, as of now (the fetch time).
+// The period's kickoff time is used when it agrees with the polled clock; otherwise the start is worked
+// back from the elapsed time ("66:32") or, failing that, the middle of the polled minute.
+// Returns nil for matches that aren't running.
+func (s status) periodStart(state api.MatchState, now time.Time) *time.Time {
+	base, _, ok := state.Phase.Period()
+	if !ok {
+		return nil
+	}
+	polled := state.Minute + state.AddedTime
+
+	if started, ok := s.Halfs.started(state.Phase); ok {
+		minute := base + int(now.Sub(started)/time.Minute) + 1
+		// Kickoff times in another zone, or a stale half, would put the clock off by hours
+		if polled == 0 || (minute >= polled-2 && minute <= polled+2) {
+			return &started
+		}
+	}
+
+	if s.LiveTime != nil {
+		if elapsed, ok := parseElapsed(s.LiveTime.Long); ok && elapsed >= time.Duration(base)*time.Minute {
+			start := now.Add(-(elapsed - time.Duration(base)*time.Minute))
+			return &start
+		}
+	}
+
+	if polled > base {
+		start := now.Add(-(time.Duration(polled-base-1)*time.Minute + 30*time.Second))
+		return &start
+	}
+	return nil
+}
+
+// parseElapsed parses an elapsed match time such as "66:32".
+func parseElapsed(text string) (time.Duration, bool) {
+	minutes, seconds, found := strings.Cut(strings.TrimSpace(text), ":")
+	if !found {
+		return 0, false
+	}
+	m, err := strconv.Atoi(minutes)
+	if err != nil {
+		return 0, false
+	}
+	sec, err := strconv.Atoi(seconds)
+	if err != nil {
+		return 0, false
+	}
+	return time.Duration(m)*time.Minute + time.Duration(sec)*time.Second, true
+}
@@ -0,0 +1,309 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestParseMatchState(t *testing.T) {
+	tests := []struct {
+		desc                         string
+		started, finished, cancelled bool
+		reason, clock                string
+		want                         api.MatchState
+		wantLabel                    string
+	}{
+		{"not started", false, false, false, "", "", api.MatchState{Phase: api.PhasePreMatch}, ""},
+		{"first half", true, false, false, "", "23’", api.MatchState{Phase: api.PhaseFirstHalf, Minute: 23}, "23'"},
+		{"first half stoppage time", true, false, false, "", "45+2’", api.MatchState{Phase: api.PhaseFirstHalf, Minute: 45, AddedTime: 2}, "45+2'"},
+		{"half-time", true, false, false, "HT halftime_short", "HT", api.MatchState{Phase: api.PhaseHalfTime}, "HT"},
+		{"second half", true, false, false, "", "67'", api.MatchState{Phase: api.PhaseSecondHalf, Minute: 67}, "67'"},
+		{"extra time first half", true, false, false, "", "98’", api.MatchState{Phase: api.PhaseExtraTimeFirst, Minute: 98}, "98'"},
+		{"extra time second half", true, false, false, "", "120+1’", api.MatchState{Phase: api.PhaseExtraTimeSecond, Minute: 120, AddedTime: 1}, "120+1'"},
+		{"shootout in progress", true, false, false, "", "Pen", api.MatchState{Phase: api.PhasePenalties}, "Pens"},
+		{"suspended", true, false, false, "Susp", "", api.MatchState{Phase: api.PhaseSuspended}, "Suspended"},
+		{"interrupted", true, false, false, "Int interrupted_short", "", api.MatchState{Phase: api.PhaseInterrupted}, "Interrupted"},
+		{"interrupted clock", true, false, false, "", "INT", api.MatchState{Phase: api.PhaseInterrupted}, "Interrupted"},
+		{"break before extra time", true, false, false, "Break extra_time_break", "", api.MatchState{Phase: api.PhaseExtraTimeBreak}, "ET break"},
+		{"extra time half-time", true, false, false, "ET HT", "ET HT", api.MatchState{Phase: api.PhaseExtraTimeBreak}, "ET break"},
+		{"extra time half-time key", true, false, false, "HT extra_time_halftime_short", "", api.MatchState{Phase: api.PhaseExtraTimeBreak}, "ET break"},
+		{"full time", true, true, false, "FT fulltime_short", "", api.MatchState{Phase: api.PhaseFullTime}, "FT"},
+		{"after extra time", true, true, false, "AET after_extra_time_short", "", api.MatchState{Phase: api.PhaseAfterExtraTime}, "AET"},
+		{"after penalties", true, true, false, "Pen penalties_short After penalties", "", api.MatchState{Phase: api.PhaseAfterPenalties}, "AP"},
+		{"postponed", false, false, true, "PPD postponed_short", "", api.MatchState{Phase: api.PhasePostponed}, "Postponed"},
+		{"abandoned", true, false, true, "Ab. abandoned_short", "", api.MatchState{Phase: api.PhaseAbandoned}, "Abandoned"},
+		{"cancelled", false, false, true, "", "", api.MatchState{Phase: api.PhaseCancelled}, "Cancelled"},
+	}
+
+	for _, tt := range tests {
+		got := ParseMatchState(tt.started, tt.finished, tt.cancelled, tt.reason, tt.clock)
+		if got != tt.want {
+			t.Errorf("%s: ParseMatchState() = %+v; want %+v", tt.desc, got, tt.want)
+		}
+		if label := got.Label(); label != tt.wantLabel {
+			t.Errorf("%s: Label() = %q; want %q", tt.desc, label, tt.wantLabel)
+		}
+	}
+}
+
+func TestMatchStateStatus(t *testing.T) {
+	tests := []struct {
+		phase api.MatchPhase
+		want  api.MatchStatus
+	}{
+		{api.PhasePreMatch, api.MatchStatusNotStarted},
+		{api.PhaseHalfTime, api.MatchStatusLive},
+		{api.PhaseSuspended, api.MatchStatusLive},
+		{api.PhaseInterrupted, api.MatchStatusLive},
+		{api.PhaseExtraTimeBreak, api.MatchStatusLive},
+		{api.PhasePenalties, api.MatchStatusLive},
+		{api.PhaseAfterPenalties, api.MatchStatusFinished},
+		{api.PhasePostponed, api.MatchStatusPostponed},
+		{api.PhaseAbandoned, api.MatchStatusCancelled},
+	}
+
+	for _, tt := range tests {
+		if got := (api.MatchState{Phase: tt.phase}).Status(); got != tt.want {
+			t.Errorf("Status() for %s = %s; want %s", tt.phase, got, tt.want)
+		}
+	}
+}
+
+func TestPeriodStart(t *testing.T) {
+	now := time.Date(2026, 3, 14, 16, 10, 0, 0, time.UTC)
+	secondHalf := api.MatchState{Phase: api.PhaseSecondHalf, Minute: 67}
+
+	tests := []struct {
+		desc   string
+		status status
+		state  api.MatchState
+		want   time.Duration // How long before now the period started; 0 for nil
+	}{
+		{"kickoff time agrees with the clock", status{Halfs: &fotmobHalfs{SecondHalfStarted: "14.03.2026 15:48:20"}}, secondHalf, 21*time.Minute + 40*time.Second},
+		{"kickoff time in another zone falls back to elapsed time", status{Halfs: &fotmobHalfs{SecondHalfStarted: "14.03.2026 16:48:20"}, LiveTime: &liveTime{Long: "66:32"}}, secondHalf, 21*time.Minute + 32*time.Second},
+		{"elapsed time", status{LiveTime: &liveTime{Short: "67’", Long: "66:32"}}, secondHalf, 21*time.Minute + 32*time.Second},
+		{"polled minute only", status{}, secondHalf, 21*time.Minute + 30*time.Second},
+		{"stoppage time", status{}, api.MatchState{Phase: api.PhaseFirstHalf, Minute: 45, AddedTime: 2}, 46*time.Minute + 30*time.Second},
+		{"half-time", status{LiveTime: &liveTime{Long: "45:00"}}, api.MatchState{Phase: api.PhaseHalfTime}, 0},
+	}
+
+	for _, tt := range tests {
+		got := tt.status.periodStart(tt.state, now)
+		switch {
+		case tt.want == 0 && got != nil:
+			t.Errorf("%s: periodStart() = %v; want nil", tt.desc, got)
+		case tt.want != 0 && (got == nil || now.Sub(*got) != tt.want):
+			t.Errorf("%s: periodStart() = %v; want %v before now", tt.desc, got, tt.want)
+		}
+	}
+}
+
+func TestStateAtRunsClock(t *testing.T) {
+	start := time.Date(2026, 3, 14, 15, 48, 20, 0, time.UTC)
+	match := api.Match{State: api.MatchState{Phase: api.PhaseSecondHalf, Minute: 67}, PeriodStart: &start}
+
+	tests := []struct {
+		desc      string
+		after     time.Duration
+		wantLabel string
+	}{
+		{"at the poll", 21*time.Minute + 40*time.Second, "67'"},
+		{"a minute later", 22*time.Minute + 40*time.Second, "68'"},
+		{"stoppage time", 46*time.Minute + 5*time.Second, "90+2'"},
+		{"never behind the poll", 5 * time.Minute, "67'"},
+		{"out of sync", 3 * time.Hour, "67'"},
+	}
+
+	for _, tt := range tests {
+		if got := match.StateAt(start.Add(tt.after)).Label(); got != tt.wantLabel {
+			t.Errorf("%s: StateAt().Label() = %q; want %q", tt.desc, got, tt.wantLabel)
+		}
+	}
+
+	halfTime := api.Match{State: api.MatchState{Phase: api.PhaseHalfTime}, PeriodStart: &start}
+	if got := halfTime.StateAt(start.Add(time.Hour)).Label(); got != "HT" {
+		t.Errorf("half-time: StateAt().Label() = %q; want %q", got, "HT")
+	}
+}
+
+func TestStatusAggregate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		input    string
+		wantOK   bool
+		wantHome int
+		wantAway int
+	}{
+		{"spaced", "2 - 1", true, 2, 1},
+		{"compact", "0-3", true, 0, 3},
+		{"missing", "", false, 0, 0},
+		{"single number", "3", false, 0, 0},
+		{"not numbers", "a - b", false, 0, 0},
+		{"too many parts", "1 - 1 - 2", false, 0, 0},
+	}
+
+	for _, tt := range tests {
+		home, away := status{AggregatedStr: tt.input}.aggregate()
+		if (home != nil) != tt.wantOK || (away != nil) != tt.wantOK {
+			t.Errorf("%s: aggregate(%q) = %v, %v; want set %v", tt.desc, tt.input, home, away, tt.wantOK)
+			continue
+		}
+		if tt.wantOK && (*home != tt.wantHome || *away != tt.wantAway) {
+			t.Errorf("%s: aggregate(%q) = %d - %d; want %d - %d", tt.desc, tt.input, *home, *away, tt.wantHome, tt.wantAway)
+		}
+	}
+}
+
+func TestPenaltyOutcome(t *testing.T) {
+	tests := []struct {
+		desc  string
+		input string
+		want  api.PenaltyOutcome
+	}{
+		{"goal", "Goal", api.PenaltyScored},
+		{"lower case goal", "goal", api.PenaltyScored},
+		{"saved", "SavedPenalty", api.PenaltySaved},
+		{"missed", "MissedPenalty", api.PenaltyMissed},
+		{"missed goal", "GoalMissed", api.PenaltyMissed},
+		{"unknown", "Woodwork", api.PenaltyMissed},
+	}
+
+	for _, tt := range tests {
+		if got := penaltyOutcome(tt.input); got != tt.want {
+			t.Errorf("%s: penaltyOutcome(%q) = %q; want %q", tt.desc, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseShootout(t *testing.T) {
+	home := api.Team{ID: 1, Name: "Home"}
+	away := api.Team{ID: 2, Name: "Away"}
+
+	tests := []struct {
+		desc      string
+		input     string
+		want      []api.PenaltyKick
+		wantScore []int // [home, away]; nil when no shootout
+	}{
+		{
+			desc:  "no shootout",
+			input: `{"content": {"matchFacts": {"events": {"events": [{"type": "Goal", "isHome": true}]}}}}`,
+		},
+		{
+			desc: "sudden death",
+			input: `{"content": {"matchFacts": {"events": {"penaltyShootoutEvents": [
+				{"type": "Goal", "isHome": true, "player": {"id": 10, "name": "A"}},
+				{"type": "Goal", "isHome": false, "fullName": "B", "playerId": 20},
+				{"type": "SavedPenalty", "isHome": true, "nameStr": "C"},
+				{"type": "MissedPenalty", "isHome": false, "nameStr": "D"},
+				{"type": "Goal", "isHome": true, "nameStr": "E"},
+				{"type": "Goal", "isHome": false, "nameStr": "F"},
+				{"type": "Goal", "isHome": true, "nameStr": "G"},
+				{"type": "SavedPenalty", "isHome": false, "nameStr": "H"}
+			]}}}}`,
+			want: []api.PenaltyKick{
+				{Team: home, Player: "A", PlayerID: 10, Outcome: api.PenaltyScored, HomeScore: 1, AwayScore: 0},
+				{Team: away, Player: "B", PlayerID: 20, Outcome: api.PenaltyScored, HomeScore: 1, AwayScore: 1},
+				{Team: home, Player: "C", Outcome: api.PenaltySaved, HomeScore: 1, AwayScore: 1},
+				{Team: away, Player: "D", Outcome: api.PenaltyMissed, HomeScore: 1, AwayScore: 1},
+				{Team: home, Player: "E", Outcome: api.PenaltyScored, HomeScore: 2, AwayScore: 1},
+				{Team: away, Player: "F", Outcome: api.PenaltyScored, HomeScore: 2, AwayScore: 2},
+				{Team: home, Player: "G", Outcome: api.PenaltyScored, HomeScore: 3, AwayScore: 2},
+				{Team: away, Player: "H", Outcome: api.PenaltySaved, HomeScore: 3, AwayScore: 2},
+			},
+			wantScore: []int{3, 2},
+		},
+		{
+			desc: "kicks flagged in events, header score wins",
+			input: `{"header": {"status": {"penalties": [5, "4"]}},
+				"content": {"matchFacts": {"events": {"events": [
+					{"type": "Goal", "isHome": true, "nameStr": "A"},
+					{"type": "Goal", "isHome": true, "isPenaltyShootoutEvent": true, "nameStr": "B", "penShootoutScore": [4, 4]},
+					{"type": "Goal", "isHome": false, "isPenaltyShootoutEvent": true, "nameStr": "C"}
+				]}}}}`,
+			want: []api.PenaltyKick{
+				{Team: home, Player: "B", Outcome: api.PenaltyScored, HomeScore: 4, AwayScore: 4},
+				{Team: away, Player: "C", Outcome: api.PenaltyScored, HomeScore: 4, AwayScore: 5},
+			},
+			wantScore: []int{5, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		var m fotmobMatchDetails
+		if err := json.Unmarshal([]byte(tt.input), &m); err != nil {
+			t.Fatalf("%s: unmarshal: %v", tt.desc, err)
+		}
+		details := &api.MatchDetails{Match: api.Match{HomeTeam: home, AwayTeam: away}}
+		m.parseShootout(details)
+
+		if len(details.Shootout) != len(tt.want) {
+			t.Errorf("%s: parseShootout() returned %d kicks; want %d: %+v", tt.desc, len(details.Shootout), len(tt.want), details.Shootout)
+			continue
+		}
+		for i := range tt.want {
+			if details.Shootout[i] != tt.want[i] {
+				t.Errorf("%s: kick %d = %+v; want %+v", tt.desc, i, details.Shootout[i], tt.want[i])
+			}
+		}
+
+		switch {
+		case tt.wantScore == nil && details.Penalties != nil:
+			t.Errorf("%s: Penalties = %d - %d; want nil", tt.desc, *details.Penalties.Home, *details.Penalties.Away)
+		case tt.wantScore != nil && details.Penalties == nil:
+			t.Errorf("%s: Penalties = nil; want %d - %d", tt.desc, tt.wantScore[0], tt.wantScore[1])
+		case tt.wantScore != nil && (*details.Penalties.Home != tt.wantScore[0] || *details.Penalties.Away != tt.wantScore[1]):
+			t.Errorf("%s: Penalties = %d - %d; want %d - %d", tt.desc, *details.Penalties.Home, *details.Penalties.Away, tt.wantScore[0], tt.wantScore[1])
+		}
+	}
+}
+
+func TestToAPIMatchDetailsUnavailable(t *testing.T) {
+	raw := `{
+		"general": {"matchId": "100", "homeTeam": {"id": 1, "name": "Home"}, "awayTeam": {"id": 2, "name": "Away"}},
+		"content": {"lineup": {"lineup": [
+			{"teamId": 2, "unavailable": [
+				{"id": 20, "name": "Suspended", "shirt": 4, "unavailability": {"type": "Suspension", "expectedReturn": "Next match"}}
+			]},
+			{"teamId": 1, "unavailable": [
+				{"id": 10, "name": "Injured", "shirt": 9, "unavailability": {"type": "injury", "expectedReturn": "Late January 2026"}},
+				{"id": 11, "name": "Unknown reason"}
+			]}
+		]}}
+	}`
+
+	var m fotmobMatchDetails
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	details := m.toAPIMatchDetails()
+
+	tests := []struct {
+		desc string
+		got  []api.UnavailablePlayer
+		want []api.UnavailablePlayer
+	}{
+		{"home", details.HomeUnavailable, []api.UnavailablePlayer{
+			{ID: 10, Name: "Injured", Number: 9, Reason: "injury", ExpectedReturn: "Late January 2026"},
+			{ID: 11, Name: "Unknown reason"},
+		}},
+		{"away", details.AwayUnavailable, []api.UnavailablePlayer{
+			{ID: 20, Name: "Suspended", Number: 4, Reason: "suspension", ExpectedReturn: "Next match"},
+		}},
+	}
+
+	for _, tt := range tests {
+		if len(tt.got) != len(tt.want) {
+			t.Errorf("%s: unavailable = %+v; want %+v", tt.desc, tt.got, tt.want)
+			continue
+		}
+		for i := range tt.want {
+			if tt.got[i] != tt.want[i] {
+				t.Errorf("%s: unavailable %d = %+v; want %+v", tt.desc, i, tt.got[i], tt.want[i])
+			}
+		}
+	}
+}
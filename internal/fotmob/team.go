@@ -0,0 +1,323 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// Limits for the team page lists.
+const (
+	maxTeamResults    = 10
+	maxTeamFixtures   = 10
+	maxTeamTopScorers = 5
+)
+
+// squadGroupPositions maps FotMob squad group titles to display positions.
+var squadGroupPositions = map[string]string{
+	"coach":       "Coach",
+	"keepers":     "Goalkeeper",
+	"defenders":   "Defender",
+	"midfielders": "Midfielder",
+	"attackers":   "Attacker",
+}
+
+// fotmobTeamResponse represents the FotMob /teams response (only the parts we use).
+type fotmobTeamResponse struct {
+	Details struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		ShortName string `json:"shortName"`
+		Country   string `json:"country"`
+	} `json:"details"`
+	Overview struct {
+		Table      []fotmobTableBlock `json:"table"`
+		TopPlayers struct {
+			ByGoals struct {
+				Players []struct {
+					ID    int     `json:"id"`
+					Name  string  `json:"name"`
+					Value float64 `json:"value"`
+				} `json:"players"`
+			} `json:"byGoals"`
+		} `json:"topPlayers"`
+	} `json:"overview"`
+	Fixtures struct {
+		AllFixtures struct {
+			Fixtures []fotmobTeamFixture `json:"fixtures"`
+		} `json:"allFixtures"`
+	} `json:"fixtures"`
+	Squad json.RawMessage `json:"squad"`
+}
+
+// fotmobStandingRow represents a standings row as FotMob returns it in table blocks.
+type fotmobStandingRow struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	ShortName   string `json:"shortName"`
+	Idx         int    `json:"idx"`
+	Played      int    `json:"played"`
+	Wins        int    `json:"wins"`
+	Draws       int    `json:"draws"`
+	Losses      int    `json:"losses"`
+	ScoresStr   string `json:"scoresStr"` // e.g., "45-20"
+	GoalConDiff int    `json:"goalConDiff"`
+	Pts         int    `json:"pts"`
+}
+
+// toAPITableEntry converts fotmobStandingRow to api.LeagueTableEntry.
+func (r fotmobStandingRow) toAPITableEntry() api.LeagueTableEntry {
+	entry := api.LeagueTableEntry{
+		Position: r.Idx,
+		Team: api.Team{
+			ID:        r.ID,
+			Name:      r.Name,
+			ShortName: r.ShortName,
+		},
+		Played:         r.Played,
+		Won:            r.Wins,
+		Drawn:          r.Draws,
+		Lost:           r.Losses,
+		GoalDifference: r.GoalConDiff,
+		Points:         r.Pts,
+	}
+	if gf, ga, ok := parseScoreString(r.ScoresStr); ok {
+		entry.GoalsFor = gf
+		entry.GoalsAgainst = ga
+	}
+	return entry
+}
+
+// fotmobTeamFixture represents a fixture or result on the team page.
+type fotmobTeamFixture struct {
+	ID         json.RawMessage `json:"id"`
+	Home       fotmobFormTeam  `json:"home"`
+	Away       fotmobFormTeam  `json:"away"`
+	Tournament struct {
+		LeagueID int    `json:"leagueId"`
+		Name     string `json:"name"`
+	} `json:"tournament"`
+	Status struct {
+		UTCTime   string        `json:"utcTime"`
+		Started   bool          `json:"started"`
+		Finished  bool          `json:"finished"`
+		Cancelled bool          `json:"cancelled"`
+		ScoreStr  string        `json:"scoreStr"`
+		Reason    *fotmobReason `json:"reason,omitempty"`
+	} `json:"status"`
+}
+
+// toAPIMatch converts a team page fixture to api.Match.
+func (f fotmobTeamFixture) toAPIMatch() api.Match {
+	match := api.Match{
+		ID:        parseRawID(f.ID),
+		League:    api.League{ID: f.Tournament.LeagueID, Name: f.Tournament.Name},
+		HomeTeam:  api.Team{ID: parseRawID(f.Home.ID), Name: f.Home.Name, ShortName: f.Home.Name},
+		AwayTeam:  api.Team{ID: parseRawID(f.Away.ID), Name: f.Away.Name, ShortName: f.Away.Name},
+		MatchTime: parseTimeOrNil(f.Status.UTCTime),
+	}
+
+	match.State = ParseMatchState(f.Status.Started, f.Status.Finished, f.Status.Cancelled, f.Status.Reason.text(), "")
+	match.Status = match.State.Status()
+
+	if match.Status != api.MatchStatusNotStarted {
+		if home, away, ok := parseScoreString(f.Status.ScoreStr); ok {
+			match.HomeScore = &home
+			match.AwayScore = &away
+		}
+	}
+
+	return match
+}
+
+// fotmobSquadGroup represents one position group of the squad.
+type fotmobSquadGroup struct {
+	Title   string              `json:"title"`
+	Members []fotmobSquadMember `json:"members"`
+}
+
+// fotmobSquadMember represents a squad member.
+type fotmobSquadMember struct {
+	ID          int             `json:"id"`
+	Name        string          `json:"name"`
+	ShirtNumber json.RawMessage `json:"shirtNumber"` // number, string or null
+	CName       string          `json:"cname"`
+	Age         int             `json:"age"`
+}
+
+// TeamDetails retrieves a team's overview: results and fixtures across all competitions,
+// current league position, top scorers and squad.
+// Results are cached to avoid redundant API calls when switching between teams.
+func (c *Client) TeamDetails(ctx context.Context, teamID int) (*api.TeamDetails, error) {
+	if cached := c.cache.Team(teamID); cached != nil {
+		return cached, nil
+	}
+
+	response, err := c.fetchTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	team := response.toAPITeamDetails()
+	c.cache.SetTeam(teamID, team)
+
+	return team, nil
+}
+
+// TeamNextMatch retrieves a team's match in play, or else its next fixture in any competition.
+// Returns nil if the team has none scheduled. Not cached, so kickoffs and final whistles show straight away.
+func (c *Client) TeamNextMatch(ctx context.Context, teamID int) (*api.Match, error) {
+	response, err := c.fetchTeam(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return response.nextMatch(), nil
+}
+
+// fetchTeam retrieves the FotMob /teams page for a team.
+func (c *Client) fetchTeam(ctx context.Context, teamID int) (*fotmobTeamResponse, error) {
+	// Apply rate limiting
+	c.rateLimiter.Wait()
+
+	url := fmt.Sprintf("%s/teams?id=%d", c.baseURL, teamID)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request for team %d: %w", teamID, err)
+	}
+
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch team %d: %w", teamID, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for team %d", resp.StatusCode, teamID)
+	}
+
+	var response fotmobTeamResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decode team %d response: %w", teamID, err)
+	}
+
+	return &response, nil
+}
+
+// nextMatch returns the first fixture that is in play or yet to start, or nil if there is none.
+// Fixtures are chronological, so that is the live match if there is one.
+func (r fotmobTeamResponse) nextMatch() *api.Match {
+	for _, f := range r.Fixtures.AllFixtures.Fixtures {
+		match := f.toAPIMatch()
+		if match.Status == api.MatchStatusLive || match.Status == api.MatchStatusNotStarted {
+			return &match
+		}
+	}
+	return nil
+}
+
+// toAPITeamDetails converts the FotMob team response to api.TeamDetails.
+func (r fotmobTeamResponse) toAPITeamDetails() *api.TeamDetails {
+	team := &api.TeamDetails{
+		Team: api.Team{
+			ID:        r.Details.ID,
+			Name:      r.Details.Name,
+			ShortName: r.Details.ShortName,
+		},
+		Country: r.Details.Country,
+	}
+	if team.ShortName == "" {
+		team.ShortName = team.Name
+	}
+
+	// Table position - first table block that contains the team (primary league)
+	for _, t := range r.Overview.Table {
+		rows := t.Data.allRows()
+		for _, row := range rows {
+			if row.ID == team.ID {
+				entry := row.toAPITableEntry()
+				team.TablePosition = &entry
+				team.TableLeague = api.League{ID: t.Data.LeagueID, Name: t.Data.LeagueName}
+				break
+			}
+		}
+		if team.TablePosition != nil {
+			break
+		}
+	}
+
+	// Fixtures are chronological: finished ones first, then live and upcoming
+	var results []api.Match
+	for _, f := range r.Fixtures.AllFixtures.Fixtures {
+		match := f.toAPIMatch()
+		switch match.Status {
+		case api.MatchStatusFinished:
+			results = append(results, match)
+		case api.MatchStatusLive, api.MatchStatusNotStarted:
+			if len(team.UpcomingFixtures) < maxTeamFixtures {
+				team.UpcomingFixtures = append(team.UpcomingFixtures, match)
+			}
+		}
+	}
+	for i := len(results) - 1; i >= 0 && len(team.RecentResults) < maxTeamResults; i-- {
+		team.RecentResults = append(team.RecentResults, results[i])
+	}
+
+	for _, p := range r.Overview.TopPlayers.ByGoals.Players {
+		team.TopScorers = append(team.TopScorers, api.TeamTopScorer{
+			ID:    p.ID,
+			Name:  p.Name,
+			Goals: int(p.Value),
+		})
+		if len(team.TopScorers) >= maxTeamTopScorers {
+			break
+		}
+	}
+
+	team.Squad = parseSquad(r.Squad)
+
+	return team
+}
+
+// parseSquad converts FotMob's squad block into squad players.
+// FotMob has shipped both {"squad": [{title, members}]} and [{title, members}] shapes.
+func parseSquad(raw json.RawMessage) []api.SquadPlayer {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var groups []fotmobSquadGroup
+	var wrapped struct {
+		Squad []fotmobSquadGroup `json:"squad"`
+	}
+	if err := json.Unmarshal(raw, &wrapped); err == nil && len(wrapped.Squad) > 0 {
+		groups = wrapped.Squad
+	} else if err := json.Unmarshal(raw, &groups); err != nil {
+		return nil
+	}
+
+	var squad []api.SquadPlayer
+	for _, g := range groups {
+		position, ok := squadGroupPositions[strings.ToLower(g.Title)]
+		if !ok {
+			position = g.Title
+		}
+		for _, m := range g.Members {
+			squad = append(squad, api.SquadPlayer{
+				ID:       m.ID,
+				Name:     m.Name,
+				Number:   parseRawID(m.ShirtNumber),
+				Position: position,
+				Country:  m.CName,
+				Age:      m.Age,
+			})
+		}
+	}
+	return squad
+}
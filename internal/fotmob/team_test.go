@@ -0,0 +1,121 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestToAPITeamDetails(t *testing.T) {
+	raw := `{
+		"details": {"id": 9825, "name": "Arsenal", "country": "ENG"},
+		"overview": {
+			"table": [{"data": {"leagueId": 47, "leagueName": "Premier League", "table": {"all": [
+				{"id": 8456, "name": "Man City", "shortName": "Man City", "idx": 1, "pts": 70},
+				{"id": 9825, "name": "Arsenal", "shortName": "Arsenal", "idx": 2, "played": 30, "wins": 20, "draws": 6, "losses": 4, "scoresStr": "60-25", "goalConDiff": 35, "pts": 66}
+			]}}}],
+			"topPlayers": {"byGoals": {"players": [{"id": 1, "name": "Saka", "value": 14}, {"id": 2, "name": "Havertz", "value": 9.0}]}}
+		},
+		"fixtures": {"allFixtures": {"fixtures": [
+			{"id": 1, "home": {"id": 9825, "name": "Arsenal"}, "away": {"id": "8650", "name": "Liverpool"}, "tournament": {"leagueId": 47, "name": "Premier League"},
+				"status": {"utcTime": "2026-03-01T16:30:00Z", "started": true, "finished": true, "scoreStr": "2 - 0", "reason": {"short": "FT"}}},
+			{"id": "2", "home": {"id": 10260, "name": "Man United"}, "away": {"id": 9825, "name": "Arsenal"}, "tournament": {"leagueId": 132, "name": "FA Cup"},
+				"status": {"utcTime": "2026-03-08T15:00:00Z", "started": true, "finished": true, "scoreStr": "1 - 1", "reason": {"short": "Pen"}}},
+			{"id": 3, "home": {"id": 9825, "name": "Arsenal"}, "away": {"id": 8456, "name": "Man City"}, "tournament": {"leagueId": 47, "name": "Premier League"},
+				"status": {"utcTime": "2026-03-15T17:30:00Z"}}
+		]}},
+		"squad": {"squad": [
+			{"title": "keepers", "members": [{"id": 100, "name": "Raya", "shirtNumber": "22", "cname": "Spain", "age": 30}]},
+			{"title": "coach", "members": [{"id": 101, "name": "Arteta", "shirtNumber": null}]}
+		]}
+	}`
+
+	var response fotmobTeamResponse
+	if err := json.Unmarshal([]byte(raw), &response); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	got := response.toAPITeamDetails()
+
+	if want := (api.Team{ID: 9825, Name: "Arsenal", ShortName: "Arsenal"}); got.Team != want {
+		t.Errorf("Team = %+v; want %+v", got.Team, want)
+	}
+	if got.Country != "ENG" {
+		t.Errorf("Country = %q; want %q", got.Country, "ENG")
+	}
+
+	wantPosition := api.LeagueTableEntry{
+		Position: 2, Team: api.Team{ID: 9825, Name: "Arsenal", ShortName: "Arsenal"},
+		Played: 30, Won: 20, Drawn: 6, Lost: 4, GoalsFor: 60, GoalsAgainst: 25, GoalDifference: 35, Points: 66,
+	}
+	if got.TablePosition == nil || *got.TablePosition != wantPosition {
+		t.Errorf("TablePosition = %+v; want %+v", got.TablePosition, wantPosition)
+	}
+	if want := (api.League{ID: 47, Name: "Premier League"}); got.TableLeague != want {
+		t.Errorf("TableLeague = %+v; want %+v", got.TableLeague, want)
+	}
+
+	// Results are most recent first; the shootout result counts as finished
+	if len(got.RecentResults) != 2 || got.RecentResults[0].ID != 2 || got.RecentResults[1].ID != 1 {
+		t.Errorf("RecentResults = %+v; want matches 2, 1", got.RecentResults)
+	} else if home, away := got.RecentResults[1].HomeScore, got.RecentResults[1].AwayScore; home == nil || away == nil || *home != 2 || *away != 0 {
+		t.Errorf("RecentResults[1] score = %v - %v; want 2 - 0", home, away)
+	}
+	if len(got.UpcomingFixtures) != 1 || got.UpcomingFixtures[0].ID != 3 {
+		t.Errorf("UpcomingFixtures = %+v; want match 3", got.UpcomingFixtures)
+	} else if fixture := got.UpcomingFixtures[0]; fixture.HomeScore != nil || fixture.MatchTime == nil || fixture.League.ID != 47 {
+		t.Errorf("UpcomingFixtures[0] = %+v; want no score, a kickoff time and league 47", fixture)
+	}
+
+	wantScorers := []api.TeamTopScorer{{ID: 1, Name: "Saka", Goals: 14}, {ID: 2, Name: "Havertz", Goals: 9}}
+	if len(got.TopScorers) != len(wantScorers) {
+		t.Fatalf("TopScorers = %+v; want %+v", got.TopScorers, wantScorers)
+	}
+	for i := range wantScorers {
+		if got.TopScorers[i] != wantScorers[i] {
+			t.Errorf("TopScorers[%d] = %+v; want %+v", i, got.TopScorers[i], wantScorers[i])
+		}
+	}
+
+	wantSquad := []api.SquadPlayer{
+		{ID: 100, Name: "Raya", Number: 22, Position: "Goalkeeper", Country: "Spain", Age: 30},
+		{ID: 101, Name: "Arteta", Position: "Coach"},
+	}
+	if len(got.Squad) != len(wantSquad) {
+		t.Fatalf("Squad = %+v; want %+v", got.Squad, wantSquad)
+	}
+	for i := range wantSquad {
+		if got.Squad[i] != wantSquad[i] {
+			t.Errorf("Squad[%d] = %+v; want %+v", i, got.Squad[i], wantSquad[i])
+		}
+	}
+}
+
+func TestToAPITeamDetailsMissingFields(t *testing.T) {
+	tests := []struct {
+		desc string
+		raw  string
+	}{
+		{"empty", `{}`},
+		{"nulls", `{"overview": {"table": null}, "fixtures": {"allFixtures": null}, "squad": null}`},
+		{"team not in table", `{"details": {"id": 1, "name": "Team"}, "overview": {"table": [{"data": {"tables": [{"table": {"all": [{"id": 2}]}}]}}]}}`},
+		{"fixture without status or teams", `{"fixtures": {"allFixtures": {"fixtures": [{"id": 5}]}}}`},
+	}
+
+	for _, tt := range tests {
+		var response fotmobTeamResponse
+		if err := json.Unmarshal([]byte(tt.raw), &response); err != nil {
+			t.Fatalf("%s: unmarshal: %v", tt.desc, err)
+		}
+		got := response.toAPITeamDetails()
+		if got == nil {
+			t.Fatalf("%s: toAPITeamDetails() = nil", tt.desc)
+		}
+		if got.TablePosition != nil {
+			t.Errorf("%s: TablePosition = %+v; want nil", tt.desc, got.TablePosition)
+		}
+		if len(got.RecentResults) != 0 || len(got.TopScorers) != 0 || len(got.Squad) != 0 {
+			t.Errorf("%s: toAPITeamDetails() = %+v; want no results, scorers or squad", tt.desc, got)
+		}
+	}
+}
@@ -36,16 +36,22 @@ type league struct {
 }
 
 type status struct {
-	UTCTime   string    `json:"utcTime"`   // Can be null/empty
-	Started   *bool     `json:"started"`   // Can be null
-	Finished  *bool     `json:"finished"`  // Can be null
-	Cancelled *bool     `json:"cancelled"` // Can be null
-	LiveTime  *liveTime `json:"liveTime,omitempty"`
-	Score     *score    `json:"score,omitempty"`
+	UTCTime   string        `json:"utcTime"`   // Can be null/empty
+	Started   *bool         `json:"started"`   // Can be null
+	Finished  *bool         `json:"finished"`  // Can be null
+	Cancelled *bool         `json:"cancelled"` // Can be null
+	LiveTime  *liveTime     `json:"liveTime,omitempty"`
+	Score     *score        `json:"score,omitempty"`
+	Reason    *fotmobReason `json:"reason,omitempty"` // e.g., "HT", "AET", "Pen", "PPD"
+	Halfs     *fotmobHalfs  `json:"halfs,omitempty"`  // Period kickoff times (match details only)
+
+	AggregatedStr string            `json:"aggregatedStr,omitempty"` // e.g., "3 - 2", set for the second leg of a two-legged tie
+	Penalties     []json.RawMessage `json:"penalties,omitempty"`     // [home, away] shootout score, when present
 }
 
 type liveTime struct {
 	Short string `json:"short"`
+	Long  string `json:"long"` // Elapsed match time, e.g. "66:32"
 }
 
 type score struct {
@@ -95,29 +101,48 @@ func (m fotmobMatch) toAPIMatch() api.Match {
 		}
 	}
 
-	// Determine status - handle null boolean values
-	if m.Status.Cancelled != nil && *m.Status.Cancelled {
-		match.Status = api.MatchStatusCancelled
-	} else if m.Status.Finished != nil && *m.Status.Finished {
-		match.Status = api.MatchStatusFinished
-	} else if m.Status.Started != nil && *m.Status.Started {
-		match.Status = api.MatchStatusLive
-		if m.Status.LiveTime != nil {
-			match.LiveTime = &m.Status.LiveTime.Short
-		}
-	} else {
-		match.Status = api.MatchStatusNotStarted
-	}
+	// Determine status and phase
+	match.State = m.Status.state()
+	match.Status = match.State.Status()
+	match.PeriodStart = m.Status.periodStart(match.State, time.Now())
 
 	// Set scores if available
 	if m.Status.Score != nil {
 		match.HomeScore = &m.Status.Score.Home
 		match.AwayScore = &m.Status.Score.Away
 	}
+	match.HomeAggregate, match.AwayAggregate = m.Status.aggregate()
 
 	return match
 }
 
+// state derives the match state from the status flags (null booleans count as false), reason and live clock
+func (s status) state() api.MatchState {
+	clock := ""
+	if s.LiveTime != nil {
+		clock = s.LiveTime.Short
+	}
+	isSet := func(b *bool) bool { return b != nil && *b }
+	return ParseMatchState(isSet(s.Started), isSet(s.Finished), isSet(s.Cancelled), s.Reason.text(), clock)
+}
+
+// aggregate parses the aggregate score of a two-legged tie (nil when not a second leg)
+func (s status) aggregate() (home, away *int) {
+	h, a, ok := parseScoreString(s.AggregatedStr)
+	if !ok {
+		return nil, nil
+	}
+	return &h, &a
+}
+
+// penalties returns the [home, away] shootout score, when present
+func (s status) penalties() (home, away int, ok bool) {
+	if len(s.Penalties) != 2 {
+		return 0, 0, false
+	}
+	return int(parseRawFloat(s.Penalties[0])), int(parseRawFloat(s.Penalties[1])), true
+}
+
 // fotmobMatchDetails represents detailed match information from FotMob
 // Note: FotMob API returns a nested structure with content.matchFacts containing events
 type fotmobMatchDetails struct {
@@ -146,7 +171,8 @@ type fotmobMatchDetails struct {
 	Content struct {
 		MatchFacts struct {
 			Events struct {
-				Events []fotmobEventDetail `json:"events"`
+				Events                []fotmobEventDetail `json:"events"`
+				PenaltyShootoutEvents []fotmobEventDetail `json:"penaltyShootoutEvents,omitempty"`
 			} `json:"events"`
 			InfoBox struct {
 				Stadium struct {
@@ -157,6 +183,7 @@ type fotmobMatchDetails struct {
 				} `json:"Referee,omitempty"`
 				Attendance json.RawMessage `json:"Attendance,omitempty"` // Can be int or object
 			} `json:"infoBox,omitempty"`
+			TeamForm json.RawMessage `json:"teamForm,omitempty"` // [[home form], [away form]], parsed best-effort
 		} `json:"matchFacts"`
 		Stats struct {
 			Periods struct {
@@ -166,8 +193,10 @@ type fotmobMatchDetails struct {
 			} `json:"periods,omitempty"`
 		} `json:"stats,omitempty"`
 		Lineup struct {
-			Lineup []fotmobTeamLineup `json:"lineup"`
+			Lineup     []fotmobTeamLineup `json:"lineup"`
+			LineupType string             `json:"lineupType,omitempty"` // "predicted" before lineups are confirmed
 		} `json:"lineup,omitempty"`
+		H2H json.RawMessage `json:"h2h,omitempty"` // Head-to-head summary and matches, parsed best-effort
 	} `json:"content"`
 }
 
@@ -188,12 +217,13 @@ type fotmobStatItem struct {
 
 // fotmobTeamLineup represents a team's lineup
 type fotmobTeamLineup struct {
-	TeamID     int                  `json:"teamId"`
-	TeamName   string               `json:"teamName"`
-	Formation  string               `json:"formation"`
-	Bench      []fotmobPlayerInfo   `json:"bench"`
-	Players    [][]fotmobPlayerInfo `json:"players"` // Grouped by position rows
-	OptaLineup *struct {
+	TeamID      int                       `json:"teamId"`
+	TeamName    string                    `json:"teamName"`
+	Formation   string                    `json:"formation"`
+	Bench       []fotmobPlayerInfo        `json:"bench"`
+	Players     [][]fotmobPlayerInfo      `json:"players"` // Grouped by position rows
+	Unavailable []fotmobUnavailablePlayer `json:"unavailable,omitempty"`
+	OptaLineup  *struct {
 		Starting []fotmobPlayerInfo `json:"starting"`
 	} `json:"optaLineup,omitempty"`
 }
@@ -210,6 +240,17 @@ type fotmobPlayerInfo struct {
 	} `json:"rating,omitempty"`
 }
 
+// fotmobUnavailablePlayer represents a player listed as unavailable in a team's lineup
+type fotmobUnavailablePlayer struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Shirt          int    `json:"shirt,omitempty"`
+	Unavailability *struct {
+		Type           string `json:"type"`           // "injury", "suspension", "international", etc.
+		ExpectedReturn string `json:"expectedReturn"` // Free text, e.g. "Late January 2026"
+	} `json:"unavailability,omitempty"`
+}
+
 // fotmobEventDetail represents a single event detail from FotMob
 type fotmobEventDetail struct {
 	Time    int         `json:"time"`
@@ -239,6 +280,152 @@ type fotmobEventDetail struct {
 	AssistStr      string `json:"assistStr,omitempty"`
 	AssistInput    string `json:"assistInput,omitempty"`
 	AssistPlayerID *int   `json:"assistPlayerId,omitempty"`
+
+	IsPenaltyShootoutEvent bool  `json:"isPenaltyShootoutEvent,omitempty"`
+	PenShootoutScore       []int `json:"penShootoutScore,omitempty"` // [home, away] after this kick
+
+	GoalDescription string          `json:"goalDescription,omitempty"` // e.g., "Penalty", "Own goal"
+	VAR             json.RawMessage `json:"VAR,omitempty"`             // VAR review, {"decision": {"value": "Goal cancelled"}} or text
+	MinutesAddedStr string          `json:"minutesAddedStr,omitempty"` // Added time events, e.g., "+4"
+}
+
+// toAPIEvent converts a FotMob event into a typed api.MatchEvent.
+// isHome decides the team: for own goals FotMob credits the team that benefits,
+// while the player belongs to the opponent.
+func (e fotmobEventDetail) toAPIEvent(homeTeam, awayTeam api.Team) api.MatchEvent {
+	event := api.MatchEvent{
+		ID:        e.EventID,
+		Minute:    e.Time,
+		Kind:      e.kind(),
+		Team:      awayTeam,
+		Timestamp: time.Now(),
+	}
+	if e.IsHome {
+		event.Team = homeTeam
+	}
+
+	// Set display minute - use TimeStr if available (for stoppage time), otherwise format base minute
+	if timeStrVal, ok := e.TimeStr.(string); ok && timeStrVal != "" {
+		// Clean up TimeStr to remove spaces around + sign (e.g., "45 + 2" -> "45+2")
+		cleanTimeStr := strings.ReplaceAll(timeStrVal, " + ", "+")
+		event.DisplayMinute = cleanTimeStr + "'"
+	} else if timeStrInt, ok := e.TimeStr.(float64); ok && timeStrInt > 0 {
+		event.DisplayMinute = fmt.Sprintf("%.0f'", timeStrInt)
+	} else {
+		event.DisplayMinute = fmt.Sprintf("%d'", e.Time)
+	}
+
+	// Extract player name
+	playerName := ""
+	if e.Player != nil && e.Player.Name != "" {
+		playerName = e.Player.Name
+	} else if e.FullName != "" {
+		playerName = e.FullName
+	} else if e.NameStr != "" {
+		playerName = e.NameStr
+	}
+	if playerName != "" {
+		event.Player = &playerName
+	}
+	if e.Player != nil && e.Player.ID != 0 {
+		event.PlayerID = e.Player.ID
+	} else if e.PlayerID != nil {
+		event.PlayerID = *e.PlayerID
+	}
+
+	switch event.Kind {
+	case api.EventGoal, api.EventPenaltyGoal, api.EventOwnGoal:
+		if e.AssistInput != "" {
+			event.Assist = &e.AssistInput
+		}
+	case api.EventSubstitution:
+		// swap[0] is the player coming on, swap[1] the player going off
+		if len(e.Swap) >= 2 {
+			playerIn, playerOut := e.Swap[0].Name, e.Swap[1].Name
+			event.PlayerIn = &playerIn
+			event.PlayerInID = parseInt(e.Swap[0].ID)
+			event.Player = &playerOut
+			event.PlayerID = parseInt(e.Swap[1].ID)
+		}
+	case api.EventVAR:
+		event.Detail = parseVARDecision(e.VAR)
+		if event.Detail == "" {
+			event.Detail = e.NameStr
+		}
+	case api.EventAddedTime:
+		// Added minutes come from minutesAddedStr, timeStr or nameStr depending on the response
+		event.Player = nil
+		switch timeStr := e.TimeStr.(type) {
+		case string:
+			event.Detail = timeStr
+		case float64:
+			event.Detail = strconv.Itoa(int(timeStr))
+		}
+		if e.MinutesAddedStr != "" {
+			event.Detail = e.MinutesAddedStr
+		} else if event.Detail == "" {
+			event.Detail = playerName
+		}
+	case api.EventOther:
+		event.Detail = e.Type
+	}
+
+	return event
+}
+
+// kind maps a FotMob event type and its flags to an event kind.
+func (e fotmobEventDetail) kind() api.EventKind {
+	eventType := strings.ToLower(e.Type)
+	description := strings.ToLower(e.GoalDescription)
+
+	switch {
+	case eventType == "goal":
+		switch {
+		case (e.OwnGoal != nil && *e.OwnGoal) || strings.Contains(description, "own goal"):
+			return api.EventOwnGoal
+		case (e.IsPenalty != nil && *e.IsPenalty) || strings.Contains(description, "penalty"):
+			return api.EventPenaltyGoal
+		default:
+			return api.EventGoal
+		}
+	case strings.Contains(eventType, "missedpenalty") || strings.Contains(eventType, "penaltymissed"):
+		return api.EventMissedPenalty
+	case eventType == "card":
+		card := strings.ToLower(strings.ReplaceAll(e.Card, " ", ""))
+		switch {
+		case strings.Contains(card, "yellowred") || strings.Contains(card, "secondyellow"):
+			return api.EventSecondYellow
+		case strings.Contains(card, "red"):
+			return api.EventRedCard
+		default:
+			return api.EventYellowCard
+		}
+	case eventType == "substitution":
+		return api.EventSubstitution
+	case eventType == "var":
+		return api.EventVAR
+	case eventType == "addedtime":
+		return api.EventAddedTime
+	default:
+		return api.EventOther
+	}
+}
+
+// parseVARDecision reads the decision text of a VAR event, which is either
+// an object ({"decision": {"value": "Goal cancelled"}}) or plain text.
+func parseVARDecision(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var review struct {
+		Decision struct {
+			Value string `json:"value"`
+		} `json:"decision"`
+	}
+	if err := json.Unmarshal(raw, &review); err == nil && review.Decision.Value != "" {
+		return review.Decision.Value
+	}
+	return parseRawString(raw)
 }
 
 // toAPIMatchDetails converts fotmobMatchDetails to api.MatchDetails
@@ -246,21 +433,9 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 	// Parse match ID from string
 	matchID := parseInt(m.General.MatchID)
 
-	// Determine match status from header
-	var status api.MatchStatus
-	var liveTime *string
-	if m.Header.Status.Cancelled != nil && *m.Header.Status.Cancelled {
-		status = api.MatchStatusCancelled
-	} else if m.Header.Status.Finished != nil && *m.Header.Status.Finished {
-		status = api.MatchStatusFinished
-	} else if m.Header.Status.Started != nil && *m.Header.Status.Started {
-		status = api.MatchStatusLive
-		if m.Header.Status.LiveTime != nil {
-			liveTime = &m.Header.Status.LiveTime.Short
-		}
-	} else {
-		status = api.MatchStatusNotStarted
-	}
+	// Determine match status and phase from header
+	state := m.Header.Status.state()
+	status := state.Status()
 
 	// Parse match time
 	var matchTime *time.Time
@@ -292,10 +467,12 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 			ShortName: m.General.AwayTeam.Name, // Use full name as short name if not available
 		},
 		Status:    status,
-		LiveTime:  liveTime,
+		State:     state,
 		MatchTime: matchTime,
 		Round:     m.General.Round,
 	}
+	baseMatch.PeriodStart = m.Header.Status.periodStart(state, time.Now())
+	baseMatch.HomeAggregate, baseMatch.AwayAggregate = m.Header.Status.aggregate()
 
 	details := &api.MatchDetails{
 		Match:  baseMatch,
@@ -309,8 +486,17 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		details.Match.HomeScore = &homeScore
 		details.Match.AwayScore = &awayScore
 
+		// Parse the penalty shootout (the match score excludes it)
+		m.parseShootout(details)
+
 		// Determine winner for finished matches
-		if status == api.MatchStatusFinished {
+		if status == api.MatchStatusFinished && details.Penalties != nil && homeScore == awayScore {
+			winner := "home"
+			if *details.Penalties.Away > *details.Penalties.Home {
+				winner = "away"
+			}
+			details.Winner = &winner
+		} else if status == api.MatchStatusFinished {
 			if homeScore > awayScore {
 				winner := "home"
 				details.Winner = &winner
@@ -378,120 +564,113 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 
 	// Parse lineup information
 	m.parseLineups(details)
+	details.LineupPredicted = strings.EqualFold(m.Content.Lineup.LineupType, "predicted")
+
+	// Parse pre-match context (head-to-head, recent form)
+	details.HeadToHead = parseHeadToHead(m.Content.H2H)
+	details.HomeForm, details.AwayForm = parseTeamForm(m.Content.MatchFacts.TeamForm)
 
 	// Convert events from content.matchFacts.events
+	homeTeam := api.Team{ID: m.General.HomeTeam.ID, Name: m.General.HomeTeam.Name, ShortName: m.General.HomeTeam.Name}
+	awayTeam := api.Team{ID: m.General.AwayTeam.ID, Name: m.General.AwayTeam.Name, ShortName: m.General.AwayTeam.Name}
 	events := make([]api.MatchEvent, 0, len(m.Content.MatchFacts.Events.Events))
 	for _, e := range m.Content.MatchFacts.Events.Events {
-		// Skip non-event types like "Half"
-		if e.Type == "Half" {
+		// Skip non-event types like "Half", and shootout kicks (parsed separately)
+		if e.Type == "Half" || e.IsPenaltyShootoutEvent {
 			continue
 		}
+		events = append(events, e.toAPIEvent(homeTeam, awayTeam))
+	}
+
+	// Sort events by minute (chronological order)
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Minute < events[j].Minute
+	})
 
-		// Normalize event type to lowercase for consistent matching
-		eventType := strings.ToLower(e.Type)
+	// The status reason is authoritative for extra time (no event may fall after 90')
+	if state.Phase == api.PhaseAfterExtraTime || state.Phase == api.PhaseAfterPenalties ||
+		state.Phase == api.PhaseExtraTimeFirst || state.Phase == api.PhaseExtraTimeSecond || state.Phase == api.PhasePenalties {
+		details.ExtraTime = true
+		details.MatchDuration = 120
+	}
 
-		event := api.MatchEvent{
-			ID:        e.EventID,
-			Minute:    e.Time,
-			Type:      eventType,
-			Timestamp: time.Now(),
+	details.Events = events
+	return details
+}
+
+// parseShootout extracts the penalty shootout kicks and score.
+// Kicks come from penaltyShootoutEvents, falling back to events flagged as shootout kicks.
+func (m fotmobMatchDetails) parseShootout(details *api.MatchDetails) {
+	kicks := m.Content.MatchFacts.Events.PenaltyShootoutEvents
+	if len(kicks) == 0 {
+		for _, e := range m.Content.MatchFacts.Events.Events {
+			if e.IsPenaltyShootoutEvent {
+				kicks = append(kicks, e)
+			}
 		}
+	}
 
-		// Set display minute - use TimeStr if available (for stoppage time), otherwise format base minute
-		if timeStrVal, ok := e.TimeStr.(string); ok && timeStrVal != "" {
-			// Clean up TimeStr to remove spaces around + sign (e.g., "45 + 2" -> "45+2")
-			cleanTimeStr := strings.ReplaceAll(timeStrVal, " + ", "+")
-			event.DisplayMinute = cleanTimeStr + "'"
-		} else if timeStrInt, ok := e.TimeStr.(float64); ok && timeStrInt > 0 {
-			event.DisplayMinute = fmt.Sprintf("%.0f'", timeStrInt)
-		} else {
-			event.DisplayMinute = fmt.Sprintf("%d'", e.Time)
+	homeScore, awayScore := 0, 0
+	for _, e := range kicks {
+		kick := api.PenaltyKick{
+			Team:    details.AwayTeam,
+			Outcome: penaltyOutcome(e.Type),
+		}
+		if e.IsHome {
+			kick.Team = details.HomeTeam
 		}
 
-		// Extract player name
-		playerName := ""
 		if e.Player != nil && e.Player.Name != "" {
-			playerName = e.Player.Name
+			kick.Player = e.Player.Name
+			kick.PlayerID = e.Player.ID
 		} else if e.FullName != "" {
-			playerName = e.FullName
-		} else if e.NameStr != "" {
-			playerName = e.NameStr
-		}
-		if playerName != "" {
-			event.Player = &playerName
+			kick.Player = e.FullName
+		} else {
+			kick.Player = e.NameStr
 		}
-
-		// Extract assist
-		if e.AssistInput != "" {
-			event.Assist = &e.AssistInput
+		if kick.PlayerID == 0 && e.PlayerID != nil {
+			kick.PlayerID = *e.PlayerID
 		}
 
-		// Extract event type details
-		eventTypeDetail := ""
-		if e.Type == "Card" && e.Card != "" {
-			eventTypeDetail = strings.ToLower(e.Card)
-		} else if e.Type == "Substitution" && len(e.Swap) >= 2 {
-			// Substitution: swap[0] is player coming IN, swap[1] is player going OUT
-			// Store player out in Player field, player in in Assist field (repurposed)
-			playerIn := e.Swap[0].Name
-			playerOut := e.Swap[1].Name
-			event.Player = &playerOut
-			event.Assist = &playerIn // Repurpose Assist to store player coming in
-			eventTypeDetail = "sub"
-		} else if strings.ToLower(e.Type) == "addedtime" {
-			// Added time event - extract minutes from available fields
-			eventTypeDetail = "addedtime"
-			// Try multiple sources for added time info
-			var addedTimeStr string
-			// Check timeStr (can be string or int)
-			if timeStrVal, ok := e.TimeStr.(string); ok && timeStrVal != "" {
-				addedTimeStr = timeStrVal
-			} else if timeStrInt, ok := e.TimeStr.(float64); ok {
-				addedTimeStr = strconv.Itoa(int(timeStrInt))
-			}
-			// Check nameStr which sometimes contains the added time
-			if addedTimeStr == "" && e.NameStr != "" {
-				addedTimeStr = e.NameStr
-			}
-			// Check if there's a player field with the info
-			if addedTimeStr == "" && e.Player != nil && e.Player.Name != "" {
-				addedTimeStr = e.Player.Name
-			}
-			if addedTimeStr != "" {
-				event.Player = &addedTimeStr
+		// Keep a running score, preferring FotMob's own when given
+		if kick.Outcome == api.PenaltyScored {
+			if e.IsHome {
+				homeScore++
+			} else {
+				awayScore++
 			}
 		}
-		if eventTypeDetail != "" {
-			event.EventType = &eventTypeDetail
+		if len(e.PenShootoutScore) == 2 {
+			homeScore, awayScore = e.PenShootoutScore[0], e.PenShootoutScore[1]
 		}
+		kick.HomeScore, kick.AwayScore = homeScore, awayScore
 
-		// Set team based on isHome flag
-		homeIDInt := m.General.HomeTeam.ID
-		awayIDInt := m.General.AwayTeam.ID
-		if e.IsHome {
-			event.Team = api.Team{
-				ID:        homeIDInt,
-				Name:      m.General.HomeTeam.Name,
-				ShortName: m.General.HomeTeam.Name,
-			}
-		} else {
-			event.Team = api.Team{
-				ID:        awayIDInt,
-				Name:      m.General.AwayTeam.Name,
-				ShortName: m.General.AwayTeam.Name,
-			}
-		}
-
-		events = append(events, event)
+		details.Shootout = append(details.Shootout, kick)
 	}
 
-	// Sort events by minute (chronological order)
-	sort.Slice(events, func(i, j int) bool {
-		return events[i].Minute < events[j].Minute
-	})
+	// The header score wins over the count from kicks (the kick list may be partial)
+	if home, away, ok := m.Header.Status.penalties(); ok {
+		homeScore, awayScore = home, away
+	} else if len(kicks) == 0 {
+		return
+	}
+	details.Penalties = &struct {
+		Home *int `json:"home,omitempty"`
+		Away *int `json:"away,omitempty"`
+	}{Home: &homeScore, Away: &awayScore}
+}
 
-	details.Events = events
-	return details
+// penaltyOutcome maps a FotMob shootout event type ("Goal", "MissedPenalty", "SavedPenalty", ...) to an outcome.
+func penaltyOutcome(eventType string) api.PenaltyOutcome {
+	eventType = strings.ToLower(eventType)
+	switch {
+	case strings.Contains(eventType, "save"):
+		return api.PenaltySaved
+	case strings.Contains(eventType, "goal") && !strings.Contains(eventType, "miss"):
+		return api.PenaltyScored
+	default:
+		return api.PenaltyMissed
+	}
 }
 
 // parseStatistics extracts match statistics from FotMob response
@@ -587,52 +766,33 @@ func (m fotmobMatchDetails) parseLineups(details *api.MatchDetails) {
 			substitutes = append(substitutes, player)
 		}
 
+		// Extract unavailable players (injuries, suspensions)
+		var unavailable []api.UnavailablePlayer
+		for _, p := range lineup.Unavailable {
+			player := api.UnavailablePlayer{
+				ID:     p.ID,
+				Name:   p.Name,
+				Number: p.Shirt,
+			}
+			if p.Unavailability != nil {
+				player.Reason = strings.ToLower(p.Unavailability.Type)
+				player.ExpectedReturn = p.Unavailability.ExpectedReturn
+			}
+			unavailable = append(unavailable, player)
+		}
+
 		if isHome {
 			details.HomeStarting = starting
 			details.HomeSubstitutes = substitutes
+			details.HomeUnavailable = unavailable
 		} else {
 			details.AwayStarting = starting
 			details.AwaySubstitutes = substitutes
+			details.AwayUnavailable = unavailable
 		}
 	}
 }
 
-// fotmobTableRow represents a single row in the league table from FotMob
-type fotmobTableRow struct {
-	ID             int    `json:"id"`
-	Name           string `json:"name"`
-	ShortName      string `json:"shortName"`
-	Rank           int    `json:"rank"`
-	Played         int    `json:"played"`
-	Wins           int    `json:"wins"`
-	Draws          int    `json:"draws"`
-	Losses         int    `json:"losses"`
-	GoalsFor       int    `json:"goalsFor"`
-	GoalsAgainst   int    `json:"goalsAgainst"`
-	GoalDifference int    `json:"goalDifference"`
-	Points         int    `json:"points"`
-}
-
-// toAPITableEntry converts fotmobTableRow to api.LeagueTableEntry
-func (r fotmobTableRow) toAPITableEntry() api.LeagueTableEntry {
-	return api.LeagueTableEntry{
-		Position: r.Rank,
-		Team: api.Team{
-			ID:        r.ID,
-			Name:      r.Name,
-			ShortName: r.ShortName,
-		},
-		Played:         r.Played,
-		Won:            r.Wins,
-		Drawn:          r.Draws,
-		Lost:           r.Losses,
-		GoalsFor:       r.GoalsFor,
-		GoalsAgainst:   r.GoalsAgainst,
-		GoalDifference: r.GoalDifference,
-		Points:         r.Points,
-	}
-}
-
 // Helper function to parse time from various formats
 func parseTime(timeStr string) *time.Time {
 	formats := []string{
@@ -0,0 +1,193 @@
+package fotmob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// Watch polling cadence. Matches are polled more often when goals are most likely to matter
+// and less often during breaks and before kickoff.
+const (
+	PollInterval         = 60 * time.Second
+	ClosingPollInterval  = 30 * time.Second // From the 80th minute, extra time and shootouts
+	BreakPollInterval    = 2 * time.Minute  // Half-time, extra-time breaks and suspensions
+	PreMatchPollInterval = 5 * time.Minute  // Not kicked off yet
+)
+
+// watchRequestTimeout bounds each poll of a watched match.
+const watchRequestTimeout = 10 * time.Second
+
+// Failed polls are retried with a doubling delay, up to maxErrorBackoff,
+// and the watch gives up after MaxWatchErrors of them in a row.
+const (
+	MaxWatchErrors  = 5
+	maxErrorBackoff = 10 * time.Minute
+)
+
+// ErrWatchFailed is wrapped by the last update of a watch that gave up after MaxWatchErrors failed polls,
+// e.g. for a match ID FotMob doesn't know.
+var ErrWatchFailed = errors.New("too many failed polls")
+
+// MatchUpdate is one poll of a watched match: its details and what changed since the previous poll.
+// The first update is the starting snapshot, with no changes.
+type MatchUpdate struct {
+	Details *api.MatchDetails
+	Initial bool // First update of the watch
+
+	Events EventDiff    // Events added, removed or corrected since the previous poll
+	Score  *ScoreChange // nil when the score didn't change
+	Phase  *PhaseChange // nil when the phase didn't change (kickoff, half-time, full-time...)
+	Ended  bool         // The match is over; this is the last update
+
+	// The poll failed and Details is nil. The watch carries on, unless Err wraps ErrWatchFailed:
+	// then this is the last update.
+	Err error
+}
+
+// ScoreChange is a score that went up (goals) or down (goals ruled out) between polls.
+type ScoreChange struct {
+	PrevHome, PrevAway int
+	Home, Away         int
+}
+
+// PhaseChange is a status transition between polls, e.g. first half to half-time.
+type PhaseChange struct {
+	From, To api.MatchPhase
+}
+
+// DetailsFetcher fetches fresh details for a match.
+type DetailsFetcher func(ctx context.Context, matchID int) (*api.MatchDetails, error)
+
+// Watch polls a match and streams an update for every poll, starting with a snapshot.
+// The cadence follows the match (see MatchPollInterval). The channel is closed once the match
+// has ended (finished, postponed, abandoned or cancelled), after MaxWatchErrors failed polls
+// in a row, or when ctx is cancelled.
+func (c *Client) Watch(ctx context.Context, matchID int) <-chan MatchUpdate {
+	return WatchWith(ctx, matchID, c.MatchDetailsForceRefresh)
+}
+
+// WatchWith is Watch with another source of details, such as mock data.
+func WatchWith(ctx context.Context, matchID int, fetch DetailsFetcher) <-chan MatchUpdate {
+	updates := make(chan MatchUpdate, 1)
+	go watchMatch(ctx, matchID, fetch, MatchPollInterval, updates)
+	return updates
+}
+
+// watchMatch runs a watch until the match ends, polls keep failing or ctx is cancelled, then closes updates.
+func watchMatch(ctx context.Context, matchID int, fetch DetailsFetcher, interval func(api.MatchState) time.Duration, updates chan<- MatchUpdate) {
+	defer close(updates)
+
+	var prev *api.MatchDetails
+	var delay time.Duration
+	failures := 0 // Failed polls in a row
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		fetchCtx, cancel := context.WithTimeout(ctx, watchRequestTimeout)
+		details, err := fetch(fetchCtx, matchID)
+		cancel()
+
+		var update MatchUpdate
+		switch {
+		case err != nil:
+			update.Err = fmt.Errorf("poll match %d: %w", matchID, err)
+		case details == nil:
+			update.Err = fmt.Errorf("poll match %d: no details", matchID)
+		default:
+			update = NewMatchUpdate(prev, details)
+		}
+		if update.Err != nil {
+			failures++
+			if failures >= MaxWatchErrors {
+				update.Err = fmt.Errorf("%w (%d in a row): %w", ErrWatchFailed, failures, update.Err)
+			}
+		}
+
+		select {
+		case updates <- update:
+		case <-ctx.Done():
+			return
+		}
+
+		if update.Err != nil {
+			if failures >= MaxWatchErrors {
+				return
+			}
+			var state api.MatchState // Polled at the default interval until a poll succeeds
+			if prev != nil {
+				state = prev.State
+			}
+			delay = min(interval(state)<<(failures-1), maxErrorBackoff)
+			continue
+		}
+		if update.Ended {
+			return
+		}
+		failures = 0
+		prev = details
+		delay = interval(details.State)
+	}
+}
+
+// NewMatchUpdate compares two polls of a match. With no previous poll, the update is the initial snapshot.
+func NewMatchUpdate(prev, details *api.MatchDetails) MatchUpdate {
+	update := MatchUpdate{Details: details, Ended: matchEnded(details.State)}
+	if prev == nil {
+		update.Initial = true
+		return update
+	}
+
+	update.Events = NewLiveUpdateParser().DiffEvents(prev.Events, details.Events)
+
+	prevHome, prevAway := matchScore(prev.Match)
+	home, away := matchScore(details.Match)
+	if prevHome != home || prevAway != away {
+		update.Score = &ScoreChange{PrevHome: prevHome, PrevAway: prevAway, Home: home, Away: away}
+	}
+
+	if prev.State.Phase != details.State.Phase {
+		update.Phase = &PhaseChange{From: prev.State.Phase, To: details.State.Phase}
+	}
+	return update
+}
+
+// MatchPollInterval returns how long a watch waits before polling a match in the given state.
+func MatchPollInterval(state api.MatchState) time.Duration {
+	switch state.Phase {
+	case api.PhasePreMatch:
+		return PreMatchPollInterval
+	case api.PhaseHalfTime, api.PhaseExtraTimeBreak, api.PhaseSuspended:
+		return BreakPollInterval
+	case api.PhaseExtraTimeFirst, api.PhaseExtraTimeSecond, api.PhasePenalties:
+		return ClosingPollInterval
+	case api.PhaseSecondHalf:
+		if state.Minute >= 80 {
+			return ClosingPollInterval
+		}
+	}
+	return PollInterval
+}
+
+// matchEnded reports whether a match is over: neither waiting for kickoff nor in play.
+func matchEnded(state api.MatchState) bool {
+	return state.Phase != api.PhasePreMatch && !state.InPlay()
+}
+
+// matchScore returns a match's score, with a missing score as 0.
+func matchScore(match api.Match) (home, away int) {
+	if match.HomeScore != nil {
+		home = *match.HomeScore
+	}
+	if match.AwayScore != nil {
+		away = *match.AwayScore
+	}
+	return home, away
+}
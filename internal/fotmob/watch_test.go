@@ -0,0 +1,132 @@
+package fotmob
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestWatchMatch(t *testing.T) {
+	score := func(n int) *int { return &n }
+	poll := func(phase api.MatchPhase, minute, home, away int, events ...api.MatchEvent) *api.MatchDetails {
+		return &api.MatchDetails{
+			Match: api.Match{
+				ID:        7,
+				State:     api.MatchState{Phase: phase, Minute: minute},
+				HomeScore: score(home),
+				AwayScore: score(away),
+			},
+			Events: events,
+		}
+	}
+	goal := api.MatchEvent{ID: 1, Minute: 30, Kind: api.EventGoal, Team: api.Team{ID: 1}}
+
+	polls := []*api.MatchDetails{
+		poll(api.PhaseFirstHalf, 20, 0, 0),
+		poll(api.PhaseFirstHalf, 31, 1, 0, goal),
+		nil, // Failed poll
+		poll(api.PhaseHalfTime, 45, 1, 0, goal),
+		poll(api.PhaseFullTime, 90, 1, 0, goal),
+		poll(api.PhaseFullTime, 90, 1, 0, goal), // Never fetched: the watch ends at full-time
+	}
+	fetches := 0
+	fetch := func(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+		details := polls[fetches]
+		fetches++
+		if details == nil {
+			return nil, errors.New("timeout")
+		}
+		return details, nil
+	}
+
+	updates := make(chan MatchUpdate)
+	go watchMatch(context.Background(), 7, fetch, func(api.MatchState) time.Duration { return time.Millisecond }, updates)
+
+	var got []MatchUpdate
+	for update := range updates {
+		got = append(got, update)
+	}
+
+	if len(got) != 5 || fetches != 5 {
+		t.Fatalf("got %d updates from %d fetches; want 5 from 5", len(got), fetches)
+	}
+	if !got[0].Initial || got[0].Score != nil || got[0].Phase != nil {
+		t.Errorf("first update = %+v; want the initial snapshot", got[0])
+	}
+	if s := got[1].Score; s == nil || s.PrevHome != 0 || s.Home != 1 || len(got[1].Events.Added) != 1 {
+		t.Errorf("goal update: Score = %+v, Added = %v; want 0-0 to 1-0 with the goal added", s, got[1].Events.Added)
+	}
+	if got[2].Err == nil || got[2].Details != nil {
+		t.Errorf("failed poll update = %+v; want an error", got[2])
+	}
+	if p := got[3].Phase; p == nil || p.From != api.PhaseFirstHalf || p.To != api.PhaseHalfTime || got[3].Score != nil {
+		t.Errorf("half-time update: Phase = %+v, Score = %+v; want first half to half-time, same score", p, got[3].Score)
+	}
+	if !got[4].Ended || got[4].Phase == nil || got[4].Phase.To != api.PhaseFullTime {
+		t.Errorf("last update = %+v; want the full-time transition, ended", got[4])
+	}
+}
+
+func TestWatchMatchGivesUp(t *testing.T) {
+	fetches := 0
+	fetch := func(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+		fetches++
+		return nil, errors.New("match not found")
+	}
+
+	updates := make(chan MatchUpdate)
+	go watchMatch(context.Background(), 7, fetch, func(api.MatchState) time.Duration { return time.Millisecond }, updates)
+
+	var got []MatchUpdate
+	timeout := time.After(5 * time.Second)
+	for done := false; !done; {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				done = true
+				break
+			}
+			got = append(got, update)
+		case <-timeout:
+			t.Fatalf("watch still running after %d failed polls", fetches)
+		}
+	}
+
+	if len(got) != MaxWatchErrors || fetches != MaxWatchErrors {
+		t.Fatalf("got %d updates from %d fetches; want %d from %d", len(got), fetches, MaxWatchErrors, MaxWatchErrors)
+	}
+	for i, update := range got[:len(got)-1] {
+		if update.Err == nil || errors.Is(update.Err, ErrWatchFailed) {
+			t.Errorf("update %d error = %v; want a poll error that carries on", i, update.Err)
+		}
+	}
+	if last := got[len(got)-1]; !errors.Is(last.Err, ErrWatchFailed) {
+		t.Errorf("last update error = %v; want ErrWatchFailed", last.Err)
+	}
+}
+
+func TestWatchMatchCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fetch := func(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+		return &api.MatchDetails{Match: api.Match{State: api.MatchState{Phase: api.PhaseSecondHalf, Minute: 60}}}, nil
+	}
+
+	updates := make(chan MatchUpdate)
+	go watchMatch(ctx, 7, fetch, func(api.MatchState) time.Duration { return time.Hour }, updates)
+
+	if update := <-updates; !update.Initial {
+		t.Errorf("first update = %+v; want the initial snapshot", update)
+	}
+	cancel()
+	select {
+	case _, ok := <-updates:
+		if ok {
+			t.Error("got an update after cancel; want the channel closed")
+		}
+	case <-time.After(time.Second):
+		t.Error("channel still open a second after cancel")
+	}
+}
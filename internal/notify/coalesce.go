@@ -0,0 +1,34 @@
+package notify
+
+// Coalesce folds events of the same kind in the same match into one, so goals found
+// in a single poll make one notification rather than one each. The folded event takes
+// the place of the first, with the latest event and score and the earlier ones in Grouped.
+// Events about the match itself (kickoff, full-time...) are kept as they are.
+func Coalesce(events []Event) []Event {
+	type key struct {
+		kind    Kind
+		matchID int
+	}
+
+	coalesced := make([]Event, 0, len(events))
+	index := make(map[key]int) // Where each kind's event for a match went
+	for _, event := range events {
+		if event.Event.Kind == "" {
+			coalesced = append(coalesced, event)
+			continue
+		}
+
+		k := key{event.Kind, event.Match.ID}
+		i, seen := index[k]
+		if !seen {
+			index[k] = len(coalesced)
+			coalesced = append(coalesced, event)
+			continue
+		}
+
+		earlier := coalesced[i]
+		event.Grouped = append(append(earlier.Grouped, earlier.Event), event.Grouped...)
+		coalesced[i] = event
+	}
+	return coalesced
+}
@@ -0,0 +1,49 @@
+package notify
+
+import (
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestCoalesce(t *testing.T) {
+	name := func(s string) *string { return &s }
+	liv := api.Match{ID: 1, HomeTeam: api.Team{ShortName: "LIV"}, AwayTeam: api.Team{ShortName: "MCI"}}
+	ars := api.Match{ID: 2, HomeTeam: api.Team{ShortName: "ARS"}, AwayTeam: api.Team{ShortName: "CHE"}}
+	goal := func(match api.Match, player string, minute, homeScore, awayScore int) Event {
+		return Event{
+			Kind:      KindGoal,
+			Match:     match,
+			Event:     api.MatchEvent{Minute: minute, Kind: api.EventGoal, Team: match.HomeTeam, Player: name(player)},
+			HomeScore: homeScore,
+			AwayScore: awayScore,
+		}
+	}
+
+	events := Coalesce([]Event{
+		{Kind: KindKickoff, Match: liv},
+		goal(liv, "Salah", 3, 1, 0),
+		goal(ars, "Saka", 4, 1, 0),
+		goal(liv, "Gakpo", 6, 2, 0),
+		goal(liv, "Szoboszlai", 9, 3, 0),
+	})
+
+	if len(events) != 3 {
+		t.Fatalf("Coalesce() = %d events; want kickoff, LIV goals, ARS goal", len(events))
+	}
+	grouped := events[1]
+	if grouped.Match.ID != 1 || *grouped.Event.Player != "Szoboszlai" || grouped.HomeScore != 3 || len(grouped.Grouped) != 2 {
+		t.Fatalf("Coalesce() LIV goals = %+v; want the last goal and score with two grouped", grouped)
+	}
+	if events[0].Kind != KindKickoff || events[2].Match.ID != 2 || len(events[2].Grouped) != 0 {
+		t.Errorf("Coalesce() = %+v; want kickoff first and the ARS goal alone", events)
+	}
+
+	title, message := describe(grouped)
+	if want := "⚽ GOLAZO! ×3"; title != want {
+		t.Errorf("describe() title = %q; want %q", title, want)
+	}
+	if want := "Salah 3' [LIV]\nGakpo 6' [LIV]\nSzoboszlai 9' [LIV]\nLIV 3 - 0 MCI"; message != want {
+		t.Errorf("describe() message = %q; want %q", message, want)
+	}
+}
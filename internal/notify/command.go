@@ -0,0 +1,219 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// Command hook limits.
+const (
+	CommandQueueSize     = 32 // Notifications waiting for a free run; more are dropped
+	commandTimeout       = 10 * time.Second
+	commandMaxConcurrent = 2
+	commandWaitDelay     = time.Second // For output pipes held open by a killed command's children
+	commandStderrLimit   = 200         // Bytes of stderr kept in failure logs
+)
+
+// ErrCommandQueueFull is returned when a notification is dropped because the hook is backed up.
+var ErrCommandQueueFull = errors.New("command queue full")
+
+// errCommandClosed is returned for notifications sent after Close.
+var errCommandClosed = errors.New("command hook closed")
+
+// CommandData is the JSON a hook command reads on stdin.
+type CommandData struct {
+	Kind    Kind   `json:"kind"`    // e.g., "goal"
+	Title   string `json:"title"`   // As in desktop notifications, e.g., "⚽ GOLAZO!"
+	Message string `json:"message"` // As in desktop notifications, e.g., "Salah 34' [LIV]\nLIV 2 - 1 MCI"
+
+	Match     api.Match        `json:"match"`
+	Event     *api.MatchEvent  `json:"event,omitempty"`   // The goal, card or penalty; nil for the others
+	Grouped   []api.MatchEvent `json:"grouped,omitempty"` // Earlier events of the same kind sent along with Event
+	HomeScore int              `json:"home_score"`
+	AwayScore int              `json:"away_score"`
+}
+
+// commandRun is one queued run of the hook command.
+type commandRun struct {
+	env   []string
+	stdin []byte
+}
+
+// CommandNotifier implements EventNotifier by running a command for each notification.
+// Runs are queued and taken by a fixed number of workers, so at most MaxConcurrent
+// commands run at once; Close stops them.
+type CommandNotifier struct {
+	hook    data.CommandHook
+	timeout time.Duration
+	failLog func(message string) // Optional; gets failed runs
+
+	mu      sync.Mutex // Guards closed and sending on queue
+	closed  bool
+	queue   chan commandRun
+	workers sync.WaitGroup
+}
+
+// NewCommandNotifier creates a notifier running the hook's command and starts its workers.
+// failLog, if not nil, is told about runs that failed, timed out or exited non-zero.
+func NewCommandNotifier(hook data.CommandHook, failLog func(message string)) (*CommandNotifier, error) {
+	if strings.TrimSpace(hook.Command) == "" {
+		return nil, fmt.Errorf("hook %q: no command", hook.Name)
+	}
+	if hook.Timeout < 0 || hook.MaxConcurrent < 0 {
+		return nil, fmt.Errorf("hook %q: negative timeout or max_concurrent", hook.Name)
+	}
+
+	timeout := hook.Timeout
+	if timeout == 0 {
+		timeout = commandTimeout
+	}
+	concurrent := hook.MaxConcurrent
+	if concurrent == 0 {
+		concurrent = commandMaxConcurrent
+	}
+
+	n := &CommandNotifier{
+		hook:    hook,
+		timeout: timeout,
+		failLog: failLog,
+		queue:   make(chan commandRun, CommandQueueSize),
+	}
+	n.workers.Add(concurrent)
+	for range concurrent {
+		go n.run()
+	}
+	return n, nil
+}
+
+// Close stops taking notifications and waits for the queued runs to finish.
+func (n *CommandNotifier) Close() {
+	n.mu.Lock()
+	if !n.closed {
+		n.closed = true
+		close(n.queue)
+	}
+	n.mu.Unlock()
+	n.workers.Wait()
+}
+
+// NotifyEvent queues a run of the command for the event.
+// It doesn't wait for the run; ErrCommandQueueFull means the notification was dropped.
+func (n *CommandNotifier) NotifyEvent(event Event) error {
+	title, message := describe(event)
+	payload := CommandData{
+		Kind:      event.Kind,
+		Title:     title,
+		Message:   message,
+		Match:     event.Match,
+		Grouped:   event.Grouped,
+		HomeScore: event.HomeScore,
+		AwayScore: event.AwayScore,
+	}
+	if event.Event.Kind != "" {
+		payload.Event = &event.Event
+	}
+	stdin, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("hook %q: encode %s: %w", n.hook.Name, event.Kind, err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.closed {
+		return errCommandClosed
+	}
+	select {
+	case n.queue <- commandRun{env: commandEnv(payload), stdin: stdin}:
+		return nil
+	default:
+		return fmt.Errorf("hook %q: %w", n.hook.Name, ErrCommandQueueFull)
+	}
+}
+
+// commandEnv returns the GOLAZO_* variables describing the event.
+// Those about the goal, card or penalty are only set when there is one.
+func commandEnv(payload CommandData) []string {
+	match := payload.Match
+	env := []string{
+		"GOLAZO_EVENT=" + string(payload.Kind),
+		"GOLAZO_TITLE=" + payload.Title,
+		"GOLAZO_MESSAGE=" + payload.Message,
+		"GOLAZO_MATCH_ID=" + strconv.Itoa(match.ID),
+		"GOLAZO_LEAGUE=" + match.League.Name,
+		"GOLAZO_LEAGUE_ID=" + strconv.Itoa(match.League.ID),
+		"GOLAZO_HOME_TEAM=" + match.HomeTeam.ShortName,
+		"GOLAZO_HOME_TEAM_ID=" + strconv.Itoa(match.HomeTeam.ID),
+		"GOLAZO_AWAY_TEAM=" + match.AwayTeam.ShortName,
+		"GOLAZO_AWAY_TEAM_ID=" + strconv.Itoa(match.AwayTeam.ID),
+		"GOLAZO_HOME_SCORE=" + strconv.Itoa(payload.HomeScore),
+		"GOLAZO_AWAY_SCORE=" + strconv.Itoa(payload.AwayScore),
+	}
+	if event := payload.Event; event != nil {
+		env = append(env,
+			"GOLAZO_MINUTE="+strconv.Itoa(event.Minute),
+			"GOLAZO_TEAM="+event.Team.ShortName,
+			"GOLAZO_TEAM_ID="+strconv.Itoa(event.Team.ID),
+		)
+		if event.Player != nil {
+			env = append(env, "GOLAZO_PLAYER="+*event.Player)
+		}
+	}
+	return env
+}
+
+// run executes queued runs until the queue is closed.
+func (n *CommandNotifier) run() {
+	defer n.workers.Done()
+	for r := range n.queue {
+		if err := n.exec(r); err != nil && n.failLog != nil {
+			n.failLog(fmt.Sprintf("hook %q: %v", n.hook.Name, err))
+		}
+	}
+}
+
+// exec runs the command once through the shell, killing it after the timeout.
+// Its stdout is discarded; the start of its stderr is kept in the error.
+func (n *CommandNotifier) exec(r commandRun) error {
+	ctx, cancel := context.WithTimeout(context.Background(), n.timeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", n.hook.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", n.hook.Command)
+	}
+	cmd.Env = append(os.Environ(), r.env...)
+	cmd.Stdin = bytes.NewReader(r.stdin)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = commandWaitDelay
+
+	err := cmd.Run()
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("timed out after %s", n.timeout)
+	}
+	if output := strings.TrimSpace(stderr.String()); output != "" {
+		if len(output) > commandStderrLimit {
+			output = output[:commandStderrLimit] + "…"
+		}
+		err = fmt.Errorf("%w: %s", err, output)
+	}
+	return err
+}
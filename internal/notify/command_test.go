@@ -0,0 +1,191 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+func skipOnWindows(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("hook commands in tests are sh scripts")
+	}
+}
+
+func TestCommandEnvAndStdin(t *testing.T) {
+	skipOnWindows(t)
+	dir := t.TempDir()
+	n, err := NewCommandNotifier(data.CommandHook{
+		Name:    "lights",
+		Command: `cat > "$OUT/stdin.json"; env | grep ^GOLAZO_ | sort > "$OUT/env"`,
+	}, nil)
+	if err != nil {
+		t.Fatalf("NewCommandNotifier() error = %v", err)
+	}
+	t.Setenv("OUT", dir)
+	if err := n.NotifyEvent(testGoal()); err != nil {
+		t.Fatalf("NotifyEvent() error = %v", err)
+	}
+	n.Close()
+
+	env, err := os.ReadFile(filepath.Join(dir, "env"))
+	if err != nil {
+		t.Fatalf("command didn't run: %v", err)
+	}
+	for _, want := range []string{
+		"GOLAZO_EVENT=goal",
+		"GOLAZO_LEAGUE=Premier League",
+		"GOLAZO_HOME_TEAM=LIV",
+		"GOLAZO_AWAY_TEAM_ID=8456",
+		"GOLAZO_HOME_SCORE=2",
+		"GOLAZO_MINUTE=34",
+		"GOLAZO_PLAYER=Salah",
+	} {
+		if !strings.Contains(string(env), want+"\n") {
+			t.Errorf("env = %q; want %q", env, want)
+		}
+	}
+
+	stdin, err := os.ReadFile(filepath.Join(dir, "stdin.json"))
+	if err != nil {
+		t.Fatalf("read stdin: %v", err)
+	}
+	var payload CommandData
+	if err := json.Unmarshal(stdin, &payload); err != nil {
+		t.Fatalf("stdin %q is not JSON: %v", stdin, err)
+	}
+	if payload.Kind != KindGoal || payload.Title == "" || payload.AwayScore != 1 || payload.Event == nil || *payload.Event.Player != "Salah" {
+		t.Errorf("stdin = %+v; want the goal", payload)
+	}
+}
+
+func TestCommandFailuresLogged(t *testing.T) {
+	skipOnWindows(t)
+	tests := []struct {
+		desc    string
+		command string
+		timeout time.Duration
+		want    string // In the logged message; "" for no log
+	}{
+		{"success", "true", 0, ""},
+		{"non-zero exit", "echo 'no bridge' >&2; exit 3", 0, "exit status 3: no bridge"},
+		{"timeout", "sleep 5", 50 * time.Millisecond, "timed out after 50ms"},
+	}
+	for _, tt := range tests {
+		var mu sync.Mutex
+		var logged []string
+		n, err := NewCommandNotifier(data.CommandHook{Name: "hook", Command: tt.command, Timeout: tt.timeout}, func(message string) {
+			mu.Lock()
+			logged = append(logged, message)
+			mu.Unlock()
+		})
+		if err != nil {
+			t.Fatalf("%s: NewCommandNotifier() error = %v", tt.desc, err)
+		}
+		_ = n.NotifyEvent(testGoal())
+		n.Close()
+
+		switch {
+		case tt.want == "" && len(logged) > 0:
+			t.Errorf("%s: logged %q; want nothing", tt.desc, logged)
+		case tt.want != "" && (len(logged) != 1 || !strings.Contains(logged[0], tt.want)):
+			t.Errorf("%s: logged %q; want %q", tt.desc, logged, tt.want)
+		}
+	}
+}
+
+func TestRouterCloseWaitsForHooks(t *testing.T) {
+	skipOnWindows(t)
+	dir := t.TempDir()
+	t.Setenv("OUT", dir)
+	n, err := NewCommandNotifier(data.CommandHook{Name: "lights", Command: `sleep 0.2; touch "$OUT/ran"`}, nil)
+	if err != nil {
+		t.Fatalf("NewCommandNotifier() error = %v", err)
+	}
+	if err := n.NotifyEvent(testGoal()); err != nil {
+		t.Fatalf("NotifyEvent() error = %v", err)
+	}
+
+	NewRouter(map[string]EventNotifier{"lights": n}).Close(5 * time.Second)
+	if _, err := os.Stat(filepath.Join(dir, "ran")); err != nil {
+		t.Errorf("queued run didn't finish before Close returned: %v", err)
+	}
+	if err := n.NotifyEvent(testGoal()); !errors.Is(err, errCommandClosed) {
+		t.Errorf("NotifyEvent() after Close error = %v; want errCommandClosed", err)
+	}
+}
+
+func TestCommandConcurrencyLimit(t *testing.T) {
+	skipOnWindows(t)
+	dir := t.TempDir()
+	t.Setenv("OUT", dir)
+	// Each run marks itself running, notes how many are, then waits to be released
+	n, err := NewCommandNotifier(data.CommandHook{
+		Name:          "slow",
+		Command:       `touch "$OUT/run.$$"; ls "$OUT" | grep -c '^run\.' >> "$OUT/counts"; while [ ! -e "$OUT/release" ]; do sleep 0.01; done; rm "$OUT/run.$$"`,
+		MaxConcurrent: 2,
+	}, nil)
+	if err != nil {
+		t.Fatalf("NewCommandNotifier() error = %v", err)
+	}
+
+	// Two runs hold the workers; the queue then takes CommandQueueSize more
+	for i := 0; i < 2; i++ {
+		_ = n.NotifyEvent(testGoal())
+	}
+	for len(n.queue) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	for i := 0; i < CommandQueueSize; i++ {
+		if err := n.NotifyEvent(testGoal()); err != nil {
+			t.Fatalf("NotifyEvent() %d error = %v; want queued", i, err)
+		}
+	}
+	if err := n.NotifyEvent(testGoal()); !errors.Is(err, ErrCommandQueueFull) {
+		t.Errorf("NotifyEvent() on a full queue error = %v; want ErrCommandQueueFull", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "release"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	n.Close()
+
+	counts, err := os.ReadFile(filepath.Join(dir, "counts"))
+	if err != nil {
+		t.Fatalf("read counts: %v", err)
+	}
+	lines := strings.Fields(string(counts))
+	if len(lines) != 2+CommandQueueSize {
+		t.Errorf("got %d runs; want %d", len(lines), 2+CommandQueueSize)
+	}
+	for _, count := range lines {
+		if count != "1" && count != "2" {
+			t.Errorf("%s commands ran at once; want at most 2", count)
+		}
+	}
+}
+
+func TestNewCommandNotifierErrors(t *testing.T) {
+	tests := []struct {
+		desc string
+		hook data.CommandHook
+	}{
+		{"no command", data.CommandHook{Name: "a"}},
+		{"blank command", data.CommandHook{Name: "b", Command: "  "}},
+		{"negative timeout", data.CommandHook{Name: "c", Command: "true", Timeout: -time.Second}},
+	}
+	for _, tt := range tests {
+		if _, err := NewCommandNotifier(tt.hook, nil); err == nil {
+			t.Errorf("%s: NewCommandNotifier() error = nil; want an error", tt.desc)
+		}
+	}
+}
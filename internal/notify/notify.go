@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/assets"
@@ -42,18 +44,65 @@ func getIconPath() string {
 	return iconPath
 }
 
-// Notifier defines the interface for sending desktop notifications.
+// Notifier defines the interface for sending notifications, one method per kind.
 // This allows for easy mocking in tests and potential future implementations.
 type Notifier interface {
 	// Goal sends a notification for a new goal event.
 	Goal(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error
+
+	// GoalDisallowed sends a correction for a goal that no longer counts.
+	GoalDisallowed(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error
+
+	// RedCard sends a notification for a player sent off.
+	RedCard(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error
+
+	// Kickoff sends a notification for a match kicking off.
+	Kickoff(match api.Match) error
+
+	// HalfTime sends a notification with the half-time score.
+	HalfTime(match api.Match, homeScore, awayScore int) error
+
+	// FullTime sends a notification with the final score.
+	FullTime(match api.Match, homeScore, awayScore int) error
+
+	// PenaltyAwarded sends a notification for a penalty given.
+	PenaltyAwarded(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error
+
+	// PenaltyMissed sends a notification for a penalty missed or saved.
+	PenaltyMissed(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error
+
+	// Lineups sends a notification when a match's confirmed lineups are out.
+	Lineups(match api.Match) error
+
+	// Postponed sends a notification for a match postponed.
+	Postponed(match api.Match) error
 }
 
-// DesktopNotifier implements Notifier using native desktop notifications.
+// EventNotifier is a notification channel taking the whole event, with its league, match
+// state and any events grouped with it. The Router delivers to EventNotifiers: the desktop
+// (which is also a Notifier), webhooks and command hooks.
+type EventNotifier interface {
+	// NotifyEvent sends a notification for the event, including any grouped with it.
+	NotifyEvent(event Event) error
+}
+
+// beepInterval is the least time between terminal beeps; notifications in between show silently.
+const beepInterval = 10 * time.Second
+
+// DesktopNotifier implements Notifier and EventNotifier using native desktop notifications.
 type DesktopNotifier struct {
 	enabled bool
+
+	mu       sync.Mutex // Guards lastBeep
+	lastBeep time.Time
 }
 
+// DesktopNotifier takes both the per-kind calls and whole events.
+var (
+	_ Notifier      = (*DesktopNotifier)(nil)
+	_ EventNotifier = (*DesktopNotifier)(nil)
+)
+
 // NewDesktopNotifier creates a new desktop notifier.
 // Notifications are enabled by default.
 func NewDesktopNotifier() *DesktopNotifier {
@@ -72,21 +121,80 @@ func (n *DesktopNotifier) Enabled() bool {
 	return n.enabled
 }
 
+// NotifyEvent sends a desktop notification for any event, including grouped ones.
+func (n *DesktopNotifier) NotifyEvent(event Event) error {
+	return n.send(describe(event))
+}
+
 // Goal sends a desktop notification for a new goal event.
 // Includes scorer name, minute, team, and current score.
-// Always plays a terminal beep as a fallback notification.
+// Plays a terminal beep as a fallback notification, unless one sounded within beepInterval.
 func (n *DesktopNotifier) Goal(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error {
+	return n.send(constants.NotificationTitleGoal, formatGoalMessage(event, homeTeam, awayTeam, homeScore, awayScore))
+}
+
+// GoalDisallowed sends a desktop notification correcting an earlier goal notification,
+// for a goal ruled out by VAR or removed in a score correction.
+// The event may only carry the team when the provider gave no scorer.
+func (n *DesktopNotifier) GoalDisallowed(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error {
+	return n.send(constants.NotificationTitleGoalDisallowed, formatDisallowedMessage(event, homeTeam, awayTeam, homeScore, awayScore))
+}
+
+// RedCard sends a desktop notification for a player sent off (straight red or second yellow).
+func (n *DesktopNotifier) RedCard(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error {
+	return n.send(constants.NotificationTitleRedCard, formatRedCardMessage(event, homeTeam, awayTeam, homeScore, awayScore))
+}
+
+// Kickoff sends a desktop notification for a match kicking off.
+func (n *DesktopNotifier) Kickoff(match api.Match) error {
+	return n.send(constants.NotificationTitleKickoff, formatKickoffMessage(match))
+}
+
+// HalfTime sends a desktop notification with the half-time score.
+func (n *DesktopNotifier) HalfTime(match api.Match, homeScore, awayScore int) error {
+	return n.send(constants.NotificationTitleHalfTime, formatHalfTimeMessage(match, homeScore, awayScore))
+}
+
+// FullTime sends a desktop notification with the final score.
+func (n *DesktopNotifier) FullTime(match api.Match, homeScore, awayScore int) error {
+	return n.send(constants.NotificationTitleFullTime, formatFullTimeMessage(match, homeScore, awayScore))
+}
+
+// PenaltyAwarded sends a desktop notification for a penalty given, as confirmed by VAR.
+func (n *DesktopNotifier) PenaltyAwarded(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error {
+	return n.send(constants.NotificationTitlePenaltyAwarded, formatPenaltyAwardedMessage(event, homeTeam, awayTeam, homeScore, awayScore))
+}
+
+// PenaltyMissed sends a desktop notification for a penalty missed or saved.
+func (n *DesktopNotifier) PenaltyMissed(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error {
+	return n.send(constants.NotificationTitlePenaltyMissed, formatPenaltyMissedMessage(event, homeTeam, awayTeam, homeScore, awayScore))
+}
+
+// Lineups sends a desktop notification when a match's confirmed lineups are out.
+func (n *DesktopNotifier) Lineups(match api.Match) error {
+	return n.send(constants.NotificationTitleLineups, formatLineupsMessage(match))
+}
+
+// Postponed sends a desktop notification for a match postponed.
+func (n *DesktopNotifier) Postponed(match api.Match) error {
+	return n.send(constants.NotificationTitlePostponed, formatPostponedMessage(match))
+}
+
+// send plays a terminal beep and shows the desktop notification.
+// Beeps closer together than beepInterval are skipped.
+func (n *DesktopNotifier) send(title, message string) error {
 	if !n.enabled {
 		return nil
 	}
 
 	// Play terminal beep via stderr (bypasses bubbletea's stdout capture)
 	// This works even when the TUI is active
-	_, _ = os.Stderr.WriteString("\a")
-
-	// Build notification content
-	title := constants.NotificationTitleGoal
-	message := formatGoalMessage(event, homeTeam, awayTeam, homeScore, awayScore)
+	n.mu.Lock()
+	if now := time.Now(); now.Sub(n.lastBeep) >= beepInterval {
+		n.lastBeep = now
+		_, _ = os.Stderr.WriteString("\a")
+	}
+	n.mu.Unlock()
 
 	// Send notification via beeep (cross-platform)
 	// Errors are ignored - OS notification is best-effort, beep already played
@@ -96,8 +204,232 @@ func (n *DesktopNotifier) Goal(event api.MatchEvent, homeTeam, awayTeam api.Team
 	return nil
 }
 
+// describe returns the title and message notifying of the event, as desktop notifications show them.
+// Notifiers other than the desktop use it so every channel reads the same.
+// A grouped event lists each event on its own line above the latest score,
+// with the count in the title (e.g., "⚽ GOLAZO! ×3").
+func describe(event Event) (title, message string) {
+	title, message = describeOne(event)
+	if len(event.Grouped) == 0 {
+		return title, message
+	}
+
+	lines := make([]string, 0, len(event.Grouped)+1)
+	for _, earlier := range event.Grouped {
+		single := event
+		single.Event, single.Grouped = earlier, nil
+		_, text := describeOne(single)
+		line, _, _ := strings.Cut(text, "\n") // Leaves out the score at the time
+		lines = append(lines, line)
+	}
+	lines = append(lines, message)
+	return fmt.Sprintf("%s ×%d", title, len(event.Grouped)+1), strings.Join(lines, "\n")
+}
+
+// describeOne returns the title and message for the event alone, ignoring any grouped with it.
+func describeOne(event Event) (title, message string) {
+	home, away := event.Match.HomeTeam, event.Match.AwayTeam
+	switch event.Kind {
+	case KindGoal:
+		return constants.NotificationTitleGoal, formatGoalMessage(event.Event, home, away, event.HomeScore, event.AwayScore)
+	case KindVAR:
+		return constants.NotificationTitleGoalDisallowed, formatDisallowedMessage(event.Event, home, away, event.HomeScore, event.AwayScore)
+	case KindRedCard:
+		return constants.NotificationTitleRedCard, formatRedCardMessage(event.Event, home, away, event.HomeScore, event.AwayScore)
+	case KindPenaltyAwarded:
+		return constants.NotificationTitlePenaltyAwarded, formatPenaltyAwardedMessage(event.Event, home, away, event.HomeScore, event.AwayScore)
+	case KindPenaltyMissed:
+		return constants.NotificationTitlePenaltyMissed, formatPenaltyMissedMessage(event.Event, home, away, event.HomeScore, event.AwayScore)
+	case KindKickoff:
+		return constants.NotificationTitleKickoff, formatKickoffMessage(event.Match)
+	case KindHalfTime:
+		return constants.NotificationTitleHalfTime, formatHalfTimeMessage(event.Match, event.HomeScore, event.AwayScore)
+	case KindFullTime:
+		return constants.NotificationTitleFullTime, formatFullTimeMessage(event.Match, event.HomeScore, event.AwayScore)
+	case KindLineups:
+		return constants.NotificationTitleLineups, formatLineupsMessage(event.Match)
+	case KindPostponed:
+		return constants.NotificationTitlePostponed, formatPostponedMessage(event.Match)
+	default:
+		return string(event.Kind), ""
+	}
+}
+
+// formatRedCardMessage creates the notification message for a sending-off.
+// Format: "Player 67' [Team] - second yellow\nHome 1 - 0 Away"
+func formatRedCardMessage(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) string {
+	player := "Unknown"
+	if event.Player != nil && *event.Player != "" {
+		player = *event.Player
+	}
+
+	teamName := event.Team.ShortName
+	if teamName == "" {
+		teamName = event.Team.Name
+	}
+
+	minute := event.DisplayMinute
+	if minute == "" {
+		minute = fmt.Sprintf("%d'", event.Minute)
+	}
+
+	card := "red card"
+	if event.Kind == api.EventSecondYellow {
+		card = "second yellow"
+	}
+
+	return fmt.Sprintf("%s %s [%s] - %s\n%s %d - %d %s",
+		player,
+		minute,
+		teamName,
+		card,
+		homeTeam.ShortName,
+		homeScore,
+		awayScore,
+		awayTeam.ShortName,
+	)
+}
+
+// formatKickoffMessage creates the notification message for a kickoff.
+// Format: "Home vs Away\nLeague"
+func formatKickoffMessage(match api.Match) string {
+	return fmt.Sprintf("%s vs %s\n%s", match.HomeTeam.ShortName, match.AwayTeam.ShortName, match.League.Name)
+}
+
+// formatHalfTimeMessage creates the notification message for half-time.
+// Format: "Home 1 - 0 Away\nLeague"
+func formatHalfTimeMessage(match api.Match, homeScore, awayScore int) string {
+	return fmt.Sprintf("%s %d - %d %s\n%s",
+		match.HomeTeam.ShortName,
+		homeScore,
+		awayScore,
+		match.AwayTeam.ShortName,
+		match.League.Name,
+	)
+}
+
+// formatFullTimeMessage creates the notification message for the final score.
+// Format: "Home 2 - 1 Away (AET)\nLeague", marked only after extra time or penalties.
+func formatFullTimeMessage(match api.Match, homeScore, awayScore int) string {
+	phase := ""
+	if match.State.Phase == api.PhaseAfterExtraTime || match.State.Phase == api.PhaseAfterPenalties {
+		phase = " (" + match.State.Label() + ")"
+	}
+	return fmt.Sprintf("%s %d - %d %s%s\n%s",
+		match.HomeTeam.ShortName,
+		homeScore,
+		awayScore,
+		match.AwayTeam.ShortName,
+		phase,
+		match.League.Name,
+	)
+}
+
+// formatPenaltyAwardedMessage creates the notification message for a penalty given.
+// Format: "67' [Team] - VAR: Penalty confirmed\nHome 1 - 0 Away"
+func formatPenaltyAwardedMessage(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) string {
+	teamName := event.Team.ShortName
+	if teamName == "" {
+		teamName = event.Team.Name
+	}
+
+	minute := event.DisplayMinute
+	if minute == "" {
+		minute = fmt.Sprintf("%d'", event.Minute)
+	}
+
+	decision := "penalty"
+	if event.Detail != "" {
+		decision = "VAR: " + event.Detail
+	}
+
+	return fmt.Sprintf("%s [%s] - %s\n%s %d - %d %s",
+		minute,
+		teamName,
+		decision,
+		homeTeam.ShortName,
+		homeScore,
+		awayScore,
+		awayTeam.ShortName,
+	)
+}
+
+// formatPenaltyMissedMessage creates the notification message for a missed penalty.
+// Format: "Taker 67' [Team] - penalty missed\nHome 1 - 0 Away"
+func formatPenaltyMissedMessage(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) string {
+	taker := "Unknown"
+	if event.Player != nil && *event.Player != "" {
+		taker = *event.Player
+	}
+
+	teamName := event.Team.ShortName
+	if teamName == "" {
+		teamName = event.Team.Name
+	}
+
+	minute := event.DisplayMinute
+	if minute == "" {
+		minute = fmt.Sprintf("%d'", event.Minute)
+	}
+
+	return fmt.Sprintf("%s %s [%s] - penalty missed\n%s %d - %d %s",
+		taker,
+		minute,
+		teamName,
+		homeTeam.ShortName,
+		homeScore,
+		awayScore,
+		awayTeam.ShortName,
+	)
+}
+
+// formatLineupsMessage creates the notification message for confirmed lineups.
+// Format: "Home vs Away\nKickoff 20:00 · League" (local time; no time when unknown)
+func formatLineupsMessage(match api.Match) string {
+	kickoff := ""
+	if match.MatchTime != nil {
+		kickoff = "Kickoff " + match.MatchTime.Local().Format("15:04") + " · "
+	}
+	return fmt.Sprintf("%s vs %s\n%s%s", match.HomeTeam.ShortName, match.AwayTeam.ShortName, kickoff, match.League.Name)
+}
+
+// formatPostponedMessage creates the notification message for a postponed match.
+// Format: "Home vs Away - postponed\nLeague"
+func formatPostponedMessage(match api.Match) string {
+	return fmt.Sprintf("%s vs %s - postponed\n%s", match.HomeTeam.ShortName, match.AwayTeam.ShortName, match.League.Name)
+}
+
+// formatDisallowedMessage creates the notification message for a disallowed goal.
+// Format: "Scorer 90+2' [Team] - no goal\nHome 1 - 1 Away"
+func formatDisallowedMessage(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) string {
+	teamName := event.Team.ShortName
+	if teamName == "" {
+		teamName = event.Team.Name
+	}
+
+	var who string
+	if event.Player != nil && *event.Player != "" {
+		who = *event.Player + " "
+	}
+	switch {
+	case event.DisplayMinute != "":
+		who += event.DisplayMinute + " "
+	case event.Minute > 0:
+		who += fmt.Sprintf("%d' ", event.Minute)
+	}
+
+	return fmt.Sprintf("%s[%s] - no goal\n%s %d - %d %s",
+		who,
+		teamName,
+		homeTeam.ShortName,
+		homeScore,
+		awayScore,
+		awayTeam.ShortName,
+	)
+}
+
 // formatGoalMessage creates the notification message for a goal.
-// Format: "Scorer (Team) 34' | Home 2-1 Away"
+// Format: "Scorer (Assist|OG|pen) 90+2' [Team]\nHome 2 - 1 Away"
 func formatGoalMessage(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) string {
 	scorer := "Unknown"
 	if event.Player != nil {
@@ -110,16 +442,27 @@ func formatGoalMessage(event api.MatchEvent, homeTeam, awayTeam api.Team, homeSc
 		teamName = event.Team.Name
 	}
 
-	// Build message with assist if available
+	// Own goals and penalties are marked instead of an assist
 	assistText := ""
-	if event.Assist != nil && *event.Assist != "" {
+	switch {
+	case event.Kind == api.EventOwnGoal:
+		assistText = " (OG)"
+	case event.Kind == api.EventPenaltyGoal:
+		assistText = " (pen)"
+	case event.Assist != nil && *event.Assist != "":
 		assistText = fmt.Sprintf(" (%s)", *event.Assist)
 	}
 
-	return fmt.Sprintf("%s%s %d' [%s]\n%s %d - %d %s",
+	// Use the displayed minute so stoppage time matches the match clock (e.g., "90+3'")
+	minute := event.DisplayMinute
+	if minute == "" {
+		minute = fmt.Sprintf("%d'", event.Minute)
+	}
+
+	return fmt.Sprintf("%s%s %s [%s]\n%s %d - %d %s",
 		scorer,
 		assistText,
-		event.Minute,
+		minute,
 		teamName,
 		homeTeam.ShortName,
 		homeScore,
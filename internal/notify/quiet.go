@@ -0,0 +1,55 @@
+package notify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// Snooze lengths.
+const (
+	SnoozeDuration   = 30 * time.Minute // A timed snooze, as the TUI snooze key sets
+	SnoozeMatchLimit = 3 * time.Hour    // The longest a snooze until a match ends lasts, in case its end is never seen
+)
+
+// quietHours is a daily span of local time in minutes since midnight.
+// It spans midnight when end is before start.
+type quietHours struct {
+	start, end int
+}
+
+// parseQuietHours parses the configured span; start and end can't be the same.
+func parseQuietHours(q data.QuietHours) (quietHours, error) {
+	start, err := parseClock(q.Start)
+	if err != nil {
+		return quietHours{}, fmt.Errorf("quiet hours start: %w", err)
+	}
+	end, err := parseClock(q.End)
+	if err != nil {
+		return quietHours{}, fmt.Errorf("quiet hours end: %w", err)
+	}
+	if start == end {
+		return quietHours{}, fmt.Errorf("quiet hours start and end are both %s", q.Start)
+	}
+	return quietHours{start: start, end: end}, nil
+}
+
+// parseClock parses an "HH:MM" time of day into minutes since midnight.
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not an HH:MM time", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// contains reports whether t, in its own location, falls in the span.
+// The start minute is in; the end minute is out.
+func (q quietHours) contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+	if q.start < q.end {
+		return minute >= q.start && minute < q.end
+	}
+	return minute >= q.start || minute < q.end
+}
@@ -0,0 +1,181 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// Router sends match events to the channels chosen by the notification rules,
+// holding them back during quiet hours and while snoozed. It is safe for concurrent use.
+type Router struct {
+	channels map[string]EventNotifier
+	now      func() time.Time
+
+	mu          sync.RWMutex
+	rules       []data.NotificationRule
+	followed    map[int]bool
+	quiet       *quietHours // nil without quiet hours
+	snoozeUntil time.Time
+	snoozeMatch int // Match whose end ends the snooze; 0 for a timed snooze
+}
+
+// NewRouter creates a router delivering to the named channels (e.g., ChannelDesktop).
+// The default rules apply until Configure is called.
+func NewRouter(channels map[string]EventNotifier) *Router {
+	return &Router{
+		channels: channels,
+		now:      time.Now,
+		rules:    DefaultRules(false),
+		followed: map[int]bool{},
+	}
+}
+
+// Configure applies the notification rules, followed teams and quiet hours from the settings.
+// Without configured rules, DefaultRules apply. Quiet hours that don't parse are left off
+// and reported in the error; everything else still applies.
+func (r *Router) Configure(settings *data.Settings) error {
+	followed := make(map[int]bool, len(settings.FollowedTeams))
+	for _, team := range settings.FollowedTeams {
+		followed[team.ID] = true
+	}
+	rules := settings.NotificationRules
+	if len(rules) == 0 {
+		rules = DefaultRules(len(followed) > 0)
+	}
+	var quiet *quietHours
+	var err error
+	if settings.QuietHours != nil {
+		var q quietHours
+		if q, err = parseQuietHours(*settings.QuietHours); err == nil {
+			quiet = &q
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rules = rules
+	r.followed = followed
+	r.quiet = quiet
+	return err
+}
+
+// Snooze holds back notifications for d, replacing any snooze.
+func (r *Router) Snooze(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.snoozeUntil = r.now().Add(d)
+	r.snoozeMatch = 0
+}
+
+// SnoozeMatch holds back notifications until the match's full-time (or postponement)
+// comes through Notify, for SnoozeMatchLimit at most. It replaces any snooze.
+func (r *Router) SnoozeMatch(matchID int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.snoozeUntil = r.now().Add(SnoozeMatchLimit)
+	r.snoozeMatch = matchID
+}
+
+// Unsnooze ends any snooze.
+func (r *Router) Unsnooze() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.snoozeUntil = time.Time{}
+	r.snoozeMatch = 0
+}
+
+// Snoozed returns when the snooze ends, or the zero time when not snoozed.
+// matchID is the match whose end ends it sooner, if any.
+func (r *Router) Snoozed() (until time.Time, matchID int) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if !r.now().Before(r.snoozeUntil) {
+		return time.Time{}, 0
+	}
+	return r.snoozeUntil, r.snoozeMatch
+}
+
+// InQuietHours reports whether it is now quiet hours.
+func (r *Router) InQuietHours() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.quiet != nil && r.quiet.contains(r.now())
+}
+
+// Notify sends the event to the channels of every rule matching it, each channel once.
+// sent reports whether any channel took the event; channels without a notifier are skipped.
+// During quiet hours or a snooze nothing is sent, and the end of a snoozed-for match
+// (itself held back) ends the snooze.
+func (r *Router) Notify(event Event) (sent bool, err error) {
+	r.mu.Lock()
+	now := r.now()
+	muted := now.Before(r.snoozeUntil) || (r.quiet != nil && r.quiet.contains(now))
+	if r.snoozeMatch != 0 && r.snoozeMatch == event.Match.ID && (event.Kind == KindFullTime || event.Kind == KindPostponed) {
+		r.snoozeUntil = time.Time{}
+		r.snoozeMatch = 0
+	}
+	if muted {
+		r.mu.Unlock()
+		return false, nil
+	}
+
+	var channels []string
+	for _, rule := range r.rules {
+		if !Matches(rule, event, r.followed) {
+			continue
+		}
+		ruleChannels := rule.Channels
+		if len(ruleChannels) == 0 {
+			ruleChannels = []string{ChannelDesktop}
+		}
+		for _, channel := range ruleChannels {
+			if !slices.Contains(channels, channel) {
+				channels = append(channels, channel)
+			}
+		}
+	}
+	r.mu.Unlock()
+
+	var errs []error
+	for _, channel := range channels {
+		notifier, ok := r.channels[channel]
+		if !ok {
+			continue
+		}
+		sent = true
+		if err := notifier.NotifyEvent(event); err != nil {
+			errs = append(errs, fmt.Errorf("notify %s: %w", channel, err))
+		}
+	}
+	return sent, errors.Join(errs...)
+}
+
+// Close closes the channels that queue notifications (webhooks and command hooks),
+// letting them send what is queued, and stops waiting for them after timeout.
+func (r *Router) Close(timeout time.Duration) {
+	var wg sync.WaitGroup
+	for _, notifier := range r.channels {
+		if closer, ok := notifier.(interface{ Close() }); ok {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				closer.Close()
+			}()
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+	}
+}
@@ -0,0 +1,99 @@
+package notify
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// recorder is a channel keeping the events sent to it.
+type recorder struct {
+	events []Event
+}
+
+func (r *recorder) NotifyEvent(event Event) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func TestQuietHours(t *testing.T) {
+	at := func(hour, minute int) time.Time { return time.Date(2026, 10, 18, hour, minute, 0, 0, time.Local) }
+	tests := []struct {
+		desc       string
+		start, end string
+		at         time.Time
+		want       bool
+	}{
+		{"inside a daytime span", "13:00", "15:00", at(14, 0), true},
+		{"after a daytime span", "13:00", "15:00", at(15, 0), false},
+		{"start minute is in", "23:00", "07:00", at(23, 0), true},
+		{"past midnight", "23:00", "07:00", at(2, 30), true},
+		{"end minute is out", "23:00", "07:00", at(7, 0), false},
+		{"evening before the span", "23:00", "07:00", at(22, 59), false},
+	}
+	for _, tt := range tests {
+		q, err := parseQuietHours(data.QuietHours{Start: tt.start, End: tt.end})
+		if err != nil {
+			t.Fatalf("%s: parseQuietHours() error = %v", tt.desc, err)
+		}
+		if got := q.contains(tt.at); got != tt.want {
+			t.Errorf("%s: contains(%s) = %v; want %v", tt.desc, tt.at.Format("15:04"), got, tt.want)
+		}
+	}
+
+	for _, bad := range []data.QuietHours{{Start: "23:00"}, {Start: "11pm", End: "07:00"}, {Start: "08:00", End: "08:00"}} {
+		if _, err := parseQuietHours(bad); err == nil {
+			t.Errorf("parseQuietHours(%+v) error = nil; want an error", bad)
+		}
+	}
+}
+
+func TestRouterMuting(t *testing.T) {
+	now := time.Date(2026, 10, 18, 21, 0, 0, 0, time.Local)
+	match := api.Match{ID: 7}
+	goal := Event{Kind: KindGoal, Match: match}
+	fullTime := Event{Kind: KindFullTime, Match: match}
+	otherFullTime := Event{Kind: KindFullTime, Match: api.Match{ID: 8}}
+
+	r := NewRouter(map[string]EventNotifier{ChannelDesktop: &recorder{}})
+	r.now = func() time.Time { return now }
+	settings := &data.Settings{NotificationRules: []data.NotificationRule{{Name: "Everything"}}}
+	_ = r.Configure(settings)
+
+	steps := []struct {
+		desc    string
+		prepare func()
+		event   Event
+		want    bool // Sent
+	}{
+		{"not muted", nil, goal, true},
+		{"snoozed", func() { r.Snooze(SnoozeDuration) }, goal, false},
+		{"snooze over", func() { now = now.Add(SnoozeDuration) }, goal, true},
+		{"snoozed for the match", func() { r.SnoozeMatch(match.ID) }, goal, false},
+		{"another match ending keeps the snooze", nil, otherFullTime, false},
+		{"full-time is muted too", nil, fullTime, false},
+		{"match over", nil, goal, true},
+		{"match snooze runs out", func() { r.SnoozeMatch(match.ID); now = now.Add(SnoozeMatchLimit) }, goal, true},
+		{"unsnoozed", func() { r.Snooze(SnoozeDuration); r.Unsnooze() }, goal, true},
+		{"quiet hours", func() {
+			settings.QuietHours = &data.QuietHours{Start: "23:00", End: "07:00"}
+			_ = r.Configure(settings)
+			now = time.Date(2026, 10, 19, 1, 0, 0, 0, time.Local)
+		}, goal, false},
+		{"quiet hours over", func() { now = now.Add(6 * time.Hour) }, goal, true},
+	}
+	for _, step := range steps {
+		if step.prepare != nil {
+			step.prepare()
+		}
+		sent, err := r.Notify(step.event)
+		if err != nil {
+			t.Fatalf("%s: Notify() error = %v", step.desc, err)
+		}
+		if sent != step.want {
+			t.Errorf("%s: Notify() sent = %v; want %v", step.desc, sent, step.want)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package notify
+
+import (
+	"slices"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// Kind is a kind of match event that can notify, as named in notification rules.
+type Kind string
+
+const (
+	KindGoal     Kind = "goal"
+	KindRedCard  Kind = "red_card"
+	KindKickoff  Kind = "kickoff"
+	KindHalfTime Kind = "half_time"
+	KindFullTime Kind = "full_time"
+	KindVAR      Kind = "var" // A goal ruled out by VAR or a score correction
+
+	KindPenaltyAwarded Kind = "penalty_awarded"
+	KindPenaltyMissed  Kind = "penalty_missed" // Missed or saved, not in a shootout
+	KindLineups        Kind = "lineups"        // Confirmed lineups announced before kickoff
+	KindPostponed      Kind = "postponed"
+)
+
+// Kinds lists the event kinds in the order the settings show them.
+var Kinds = []Kind{
+	KindGoal, KindRedCard, KindKickoff, KindHalfTime, KindFullTime,
+	KindVAR, KindPenaltyAwarded, KindPenaltyMissed, KindLineups, KindPostponed,
+}
+
+// Label returns a short display label (e.g., "Red card").
+func (k Kind) Label() string {
+	switch k {
+	case KindGoal:
+		return "Goal"
+	case KindRedCard:
+		return "Red card"
+	case KindKickoff:
+		return "Kickoff"
+	case KindHalfTime:
+		return "Half-time"
+	case KindFullTime:
+		return "Full-time"
+	case KindVAR:
+		return "VAR"
+	case KindPenaltyAwarded:
+		return "Pen awarded"
+	case KindPenaltyMissed:
+		return "Pen missed"
+	case KindLineups:
+		return "Lineups"
+	case KindPostponed:
+		return "Postponed"
+	default:
+		return string(k)
+	}
+}
+
+// Match state conditions a rule can require, as named in notification rules.
+// Losing, winning and drawing are from the rule's team: one of its teams in the match,
+// else a followed team in the match.
+const (
+	WhenLosing  = "losing"
+	WhenWinning = "winning"
+	WhenDrawing = "drawing"
+	WhenLate    = "last_10_minutes" // Last 10 minutes of the second half or of extra time
+)
+
+// Conditions lists the match state conditions in the order the settings show them.
+var Conditions = []string{WhenLosing, WhenWinning, WhenDrawing, WhenLate}
+
+// ConditionLabel returns a short display label for a condition (e.g., "last 10 min").
+func ConditionLabel(condition string) string {
+	if condition == WhenLate {
+		return "last 10 min"
+	}
+	return condition
+}
+
+// ChannelDesktop is the channel for desktop notifications, the default for rules naming none.
+const ChannelDesktop = "desktop"
+
+// Event is a match event to notify about.
+type Event struct {
+	Kind  Kind
+	Match api.Match      // The match as polled: teams, league, state
+	Event api.MatchEvent // The goal, card, penalty or ruled-out goal; zero for the others
+
+	// Grouped are earlier events of the same kind folded into this one by Coalesce, oldest first
+	Grouped []api.MatchEvent
+
+	HomeScore, AwayScore int
+}
+
+// DefaultRules are the rules used when none are configured: goals and disallowed goals
+// on the desktop, for followed teams only once any team is followed.
+func DefaultRules(followingTeams bool) []data.NotificationRule {
+	return []data.NotificationRule{{
+		Name:     "Goals",
+		Followed: followingTeams,
+		Events:   []string{string(KindGoal), string(KindVAR)},
+		Channels: []string{ChannelDesktop},
+	}}
+}
+
+// Matches reports whether the rule selects the event.
+// followed holds the followed team IDs, for rules on followed teams and as the
+// point of view for losing/winning when the rule names no team.
+func Matches(rule data.NotificationRule, event Event, followed map[int]bool) bool {
+	if rule.Disabled {
+		return false
+	}
+	if len(rule.Events) > 0 && !slices.Contains(rule.Events, string(event.Kind)) {
+		return false
+	}
+	if len(rule.Leagues) > 0 && !slices.Contains(rule.Leagues, event.Match.League.ID) {
+		return false
+	}
+
+	home, away := event.Match.HomeTeam.ID, event.Match.AwayTeam.ID
+	scoped := len(rule.Teams) > 0 || rule.Followed
+	inScope := func(id int) bool {
+		return slices.Contains(rule.Teams, id) || (rule.Followed && followed[id])
+	}
+	if scoped && !inScope(home) && !inScope(away) {
+		return false
+	}
+
+	// Point of view for the score conditions
+	isOurs := inScope
+	if !scoped {
+		isOurs = func(id int) bool { return followed[id] }
+	}
+
+	for _, condition := range rule.When {
+		if !holds(condition, event, isOurs(home), isOurs(away)) {
+			return false
+		}
+	}
+	return true
+}
+
+// holds reports whether a match state condition holds for the event.
+// homeOurs and awayOurs tell which sides the score conditions are judged from.
+// Unknown conditions never hold, so a mistyped rule stays quiet.
+func holds(condition string, event Event, homeOurs, awayOurs bool) bool {
+	diff := event.HomeScore - event.AwayScore // From the home side
+	switch condition {
+	case WhenDrawing:
+		return diff == 0
+	case WhenLosing:
+		return (homeOurs && diff < 0) || (awayOurs && diff > 0)
+	case WhenWinning:
+		return (homeOurs && diff > 0) || (awayOurs && diff < 0)
+	case WhenLate:
+		state := event.Match.State
+		return (state.Phase == api.PhaseSecondHalf && state.Minute >= 80) ||
+			(state.Phase == api.PhaseExtraTimeSecond && state.Minute >= 110)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,55 @@
+package notify
+
+import (
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+func TestMatches(t *testing.T) {
+	match := func(phase api.MatchPhase, minute int) api.Match {
+		return api.Match{
+			League:   api.League{ID: 47},
+			HomeTeam: api.Team{ID: 1},
+			AwayTeam: api.Team{ID: 2},
+			State:    api.MatchState{Phase: phase, Minute: minute},
+		}
+	}
+	goal := func(homeScore, awayScore int, minute int) Event {
+		return Event{Kind: KindGoal, Match: match(api.PhaseSecondHalf, minute), HomeScore: homeScore, AwayScore: awayScore}
+	}
+	followed := map[int]bool{2: true} // The away team
+
+	tests := []struct {
+		desc  string
+		rule  data.NotificationRule
+		event Event
+		want  bool
+	}{
+		{"empty rule matches everything", data.NotificationRule{}, goal(1, 0, 60), true},
+		{"disabled rule", data.NotificationRule{Disabled: true}, goal(1, 0, 60), false},
+		{"event kind listed", data.NotificationRule{Events: []string{"red_card", "goal"}}, goal(1, 0, 60), true},
+		{"event kind not listed", data.NotificationRule{Events: []string{"full_time"}}, goal(1, 0, 60), false},
+		{"league listed", data.NotificationRule{Leagues: []int{47}}, goal(1, 0, 60), true},
+		{"league not listed", data.NotificationRule{Leagues: []int{87}}, goal(1, 0, 60), false},
+		{"team plays", data.NotificationRule{Teams: []int{1}}, goal(1, 0, 60), true},
+		{"team doesn't play", data.NotificationRule{Teams: []int{3}}, goal(1, 0, 60), false},
+		{"followed team plays", data.NotificationRule{Followed: true}, goal(1, 0, 60), true},
+		{"followed team losing", data.NotificationRule{Followed: true, When: []string{"losing"}}, goal(1, 0, 60), true},
+		{"followed team not winning", data.NotificationRule{Followed: true, When: []string{"winning"}}, goal(1, 0, 60), false},
+		{"rule team winning", data.NotificationRule{Teams: []int{1}, When: []string{"winning"}}, goal(1, 0, 60), true},
+		{"unscoped rule judged from followed team", data.NotificationRule{When: []string{"losing"}}, goal(1, 0, 60), true},
+		{"no point of view for losing", data.NotificationRule{When: []string{"losing"}}, Event{Kind: KindGoal, Match: api.Match{HomeTeam: api.Team{ID: 5}, AwayTeam: api.Team{ID: 6}}, HomeScore: 1}, false},
+		{"drawing", data.NotificationRule{When: []string{"drawing"}}, goal(1, 1, 60), true},
+		{"last 10 minutes", data.NotificationRule{When: []string{"last_10_minutes"}}, goal(1, 1, 84), true},
+		{"not the last 10 minutes", data.NotificationRule{When: []string{"last_10_minutes"}}, goal(1, 1, 60), false},
+		{"all conditions must hold", data.NotificationRule{When: []string{"drawing", "last_10_minutes"}}, goal(2, 1, 88), false},
+		{"unknown condition", data.NotificationRule{When: []string{"raining"}}, goal(1, 0, 60), false},
+	}
+	for _, tt := range tests {
+		if got := Matches(tt.rule, tt.event, followed); got != tt.want {
+			t.Errorf("%s: Matches() = %v; want %v", tt.desc, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// Webhook delivery limits.
+const (
+	WebhookQueueSize      = 32              // Notifications waiting to be posted; more are dropped
+	webhookAttempts       = 3               // Tries per notification
+	webhookRetryDelay     = 2 * time.Second // Before the second try; doubles for each next one
+	webhookRequestTimeout = 10 * time.Second
+)
+
+// ErrWebhookQueueFull is returned when a notification is dropped because the webhook is backed up.
+var ErrWebhookQueueFull = errors.New("webhook queue full")
+
+// errWebhookClosed is returned for notifications sent after Close.
+var errWebhookClosed = errors.New("webhook closed")
+
+// WebhookData is what webhook templates are executed over.
+type WebhookData struct {
+	Kind    Kind   // e.g., "goal"
+	Title   string // As in desktop notifications, e.g., "⚽ GOLAZO!"
+	Message string // As in desktop notifications, e.g., "Salah 34' [LIV]\nLIV 2 - 1 MCI"
+
+	Match     api.Match        // Teams, league and state
+	Event     api.MatchEvent   // The goal, card or penalty; zero for the others
+	Grouped   []api.MatchEvent // Earlier events of the same kind sent along with Event
+	HomeScore int
+	AwayScore int
+}
+
+// webhookPreset is the payload shape a chat service expects.
+type webhookPreset struct {
+	template    string
+	contentType string
+}
+
+// webhookPresets are the payload shapes of supported services, by preset name.
+// Templates can use json to quote a string as a JSON string.
+var webhookPresets = map[string]webhookPreset{
+	"slack":   {`{"text": {{printf "*%s*\n%s" .Title .Message | json}}}`, "application/json"},
+	"discord": {`{"content": {{printf "**%s**\n%s" .Title .Message | json}}}`, "application/json"},
+	"ntfy":    {"{{.Title}}\n{{.Message}}", "text/plain; charset=utf-8"},
+}
+
+// webhookFuncs are the functions available to webhook templates.
+var webhookFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// WebhookNotifier implements EventNotifier by posting each notification to a URL.
+// Posts are queued and sent in order by a background worker, retrying server errors;
+// Close stops it.
+type WebhookNotifier struct {
+	target      data.WebhookTarget
+	template    *template.Template
+	contentType string
+	client      *http.Client
+	retryDelay  time.Duration
+	debugLog    func(message string) // Optional; gets delivery failures
+
+	mu     sync.Mutex // Guards closed and sending on queue
+	closed bool
+	queue  chan []byte
+	done   chan struct{}
+}
+
+// NewWebhookNotifier creates a notifier posting to the target and starts its worker.
+// debugLog, if not nil, is told about deliveries that failed for good.
+func NewWebhookNotifier(target data.WebhookTarget, debugLog func(message string)) (*WebhookNotifier, error) {
+	if target.URL == "" {
+		return nil, fmt.Errorf("webhook %q: no url", target.Name)
+	}
+
+	text, contentType := target.Template, target.ContentType
+	if text == "" {
+		preset, ok := webhookPresets[strings.ToLower(target.Preset)]
+		if !ok {
+			return nil, fmt.Errorf("webhook %q: unknown preset %q (want slack, discord, ntfy or a template)", target.Name, target.Preset)
+		}
+		text, contentType = preset.template, preset.contentType
+	}
+	if contentType == "" {
+		contentType = "application/json"
+	}
+
+	tmpl, err := template.New(target.Name).Funcs(webhookFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("webhook %q: parse template: %w", target.Name, err)
+	}
+
+	n := &WebhookNotifier{
+		target:      target,
+		template:    tmpl,
+		contentType: contentType,
+		client:      &http.Client{Timeout: webhookRequestTimeout},
+		retryDelay:  webhookRetryDelay,
+		debugLog:    debugLog,
+		queue:       make(chan []byte, WebhookQueueSize),
+		done:        make(chan struct{}),
+	}
+	go n.run()
+	return n, nil
+}
+
+// Close stops taking notifications and waits for the queued ones to be posted.
+func (n *WebhookNotifier) Close() {
+	n.mu.Lock()
+	if !n.closed {
+		n.closed = true
+		close(n.queue)
+	}
+	n.mu.Unlock()
+	<-n.done
+}
+
+// NotifyEvent renders the event with the target's template and queues the post.
+// It doesn't wait for the post; ErrWebhookQueueFull means the notification was dropped.
+func (n *WebhookNotifier) NotifyEvent(event Event) error {
+	title, message := describe(event)
+	var body bytes.Buffer
+	err := n.template.Execute(&body, WebhookData{
+		Kind:      event.Kind,
+		Title:     title,
+		Message:   message,
+		Match:     event.Match,
+		Event:     event.Event,
+		Grouped:   event.Grouped,
+		HomeScore: event.HomeScore,
+		AwayScore: event.AwayScore,
+	})
+	if err != nil {
+		return fmt.Errorf("webhook %q: render %s: %w", n.target.Name, event.Kind, err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.closed {
+		return errWebhookClosed
+	}
+	select {
+	case n.queue <- body.Bytes():
+		return nil
+	default:
+		return fmt.Errorf("webhook %q: %w", n.target.Name, ErrWebhookQueueFull)
+	}
+}
+
+// run posts the queued notifications until the queue is closed.
+func (n *WebhookNotifier) run() {
+	defer close(n.done)
+	for body := range n.queue {
+		if err := n.post(body); err != nil && n.debugLog != nil {
+			n.debugLog(fmt.Sprintf("webhook %q: %v", n.target.Name, err))
+		}
+	}
+}
+
+// post sends one notification, retrying network errors, rate limits and server errors
+// with a doubling delay. Other client errors (a bad URL or payload) aren't retried.
+func (n *WebhookNotifier) post(body []byte) error {
+	delay := n.retryDelay
+	var err error
+	for attempt := 1; attempt <= webhookAttempts; attempt++ {
+		if attempt > 1 {
+			time.Sleep(delay)
+			delay *= 2
+		}
+
+		var retry bool
+		retry, err = n.postOnce(body)
+		if err == nil || !retry {
+			return err
+		}
+	}
+	return fmt.Errorf("gave up after %d attempts: %w", webhookAttempts, err)
+}
+
+// postOnce makes one request. retry reports whether a failure may pass on a later try.
+func (n *WebhookNotifier) postOnce(body []byte) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, n.target.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", n.contentType)
+	req.Header.Set("User-Agent", "golazo")
+	for key, value := range n.target.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("post: %w", err)
+	}
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	default:
+		return false, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,240 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// webhookServer records the requests it gets and answers with the given status codes in turn (200 when out).
+type webhookServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	statuses []int
+	bodies   []string
+	headers  []http.Header
+}
+
+func newWebhookServer(t *testing.T, statuses ...int) *webhookServer {
+	s := &webhookServer{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		s.mu.Lock()
+		s.bodies = append(s.bodies, string(body))
+		s.headers = append(s.headers, r.Header.Clone())
+		status := http.StatusOK
+		if len(s.statuses) > 0 {
+			status, s.statuses = s.statuses[0], s.statuses[1:]
+		}
+		s.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func testGoal() Event {
+	scorer := "Salah"
+	return Event{
+		Kind: KindGoal,
+		Match: api.Match{
+			League:   api.League{ID: 47, Name: "Premier League"},
+			HomeTeam: api.Team{ID: 8650, ShortName: "LIV"},
+			AwayTeam: api.Team{ID: 8456, ShortName: "MCI"},
+		},
+		Event:     api.MatchEvent{Minute: 34, Kind: api.EventGoal, Team: api.Team{ShortName: "LIV"}, Player: &scorer},
+		HomeScore: 2,
+		AwayScore: 1,
+	}
+}
+
+func TestWebhookPresets(t *testing.T) {
+	tests := []struct {
+		preset string
+		field  string // JSON field carrying the text; "" for a plain-text body
+	}{
+		{"slack", "text"},
+		{"discord", "content"},
+		{"ntfy", ""},
+	}
+	for _, tt := range tests {
+		server := newWebhookServer(t)
+		n, err := NewWebhookNotifier(data.WebhookTarget{Name: tt.preset, URL: server.URL, Preset: tt.preset}, nil)
+		if err != nil {
+			t.Fatalf("%s: NewWebhookNotifier() error = %v", tt.preset, err)
+		}
+		if err := n.NotifyEvent(testGoal()); err != nil {
+			t.Fatalf("%s: NotifyEvent() error = %v", tt.preset, err)
+		}
+		n.Close()
+
+		if len(server.bodies) != 1 {
+			t.Fatalf("%s: got %d requests; want 1", tt.preset, len(server.bodies))
+		}
+		text := server.bodies[0]
+		if tt.field != "" {
+			var payload map[string]string
+			if err := json.Unmarshal([]byte(text), &payload); err != nil {
+				t.Fatalf("%s: body %q is not JSON: %v", tt.preset, text, err)
+			}
+			text = payload[tt.field]
+		}
+		if !strings.Contains(text, "GOLAZO") || !strings.Contains(text, "Salah 34' [LIV]\nLIV 2 - 1 MCI") {
+			t.Errorf("%s: text = %q; want the goal title and message", tt.preset, text)
+		}
+	}
+}
+
+func TestWebhookTemplate(t *testing.T) {
+	server := newWebhookServer(t)
+	n, err := NewWebhookNotifier(data.WebhookTarget{
+		Name:        "custom",
+		URL:         server.URL,
+		Template:    `{{.Kind}}|{{.Match.League.Name}}|{{.Match.HomeTeam.ShortName}} {{.HomeScore}}-{{.AwayScore}} {{.Match.AwayTeam.ShortName}}`,
+		ContentType: "text/plain",
+		Headers:     map[string]string{"Authorization": "Bearer secret"},
+	}, nil)
+	if err != nil {
+		t.Fatalf("NewWebhookNotifier() error = %v", err)
+	}
+	_ = n.NotifyEvent(testGoal())
+	n.Close()
+
+	if want := "goal|Premier League|LIV 2-1 MCI"; len(server.bodies) != 1 || server.bodies[0] != want {
+		t.Fatalf("bodies = %q; want [%q]", server.bodies, want)
+	}
+	if got := server.headers[0].Get("Authorization"); got != "Bearer secret" {
+		t.Errorf("Authorization = %q; want the configured header", got)
+	}
+	if got := server.headers[0].Get("Content-Type"); got != "text/plain" {
+		t.Errorf("Content-Type = %q; want text/plain", got)
+	}
+}
+
+func TestWebhookRetries(t *testing.T) {
+	tests := []struct {
+		desc     string
+		statuses []int
+		want     int // Requests made
+		failed   bool
+	}{
+		{"server errors are retried", []int{500, 503}, 3, false},
+		{"rate limits are retried", []int{429}, 2, false},
+		{"client errors aren't", []int{404}, 1, true},
+		{"gives up after the last attempt", []int{500, 500, 500, 500}, webhookAttempts, true},
+	}
+	for _, tt := range tests {
+		server := newWebhookServer(t, tt.statuses...)
+		var logged []string
+		n, err := NewWebhookNotifier(data.WebhookTarget{Name: "retry", URL: server.URL, Preset: "slack"}, func(message string) {
+			logged = append(logged, message)
+		})
+		if err != nil {
+			t.Fatalf("%s: NewWebhookNotifier() error = %v", tt.desc, err)
+		}
+		n.retryDelay = time.Millisecond
+		_ = n.NotifyEvent(testGoal())
+		n.Close()
+
+		if len(server.bodies) != tt.want {
+			t.Errorf("%s: got %d requests; want %d", tt.desc, len(server.bodies), tt.want)
+		}
+		if failed := len(logged) > 0; failed != tt.failed {
+			t.Errorf("%s: logged %q; want failure logged = %v", tt.desc, logged, tt.failed)
+		}
+	}
+}
+
+func TestWebhookQueueFull(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+
+	n, err := NewWebhookNotifier(data.WebhookTarget{Name: "slow", URL: server.URL, Preset: "discord"}, nil)
+	if err != nil {
+		t.Fatalf("NewWebhookNotifier() error = %v", err)
+	}
+
+	// The first post holds the worker; wait for it to leave the queue
+	_ = n.NotifyEvent(testGoal())
+	for len(n.queue) > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	for i := 0; i < WebhookQueueSize; i++ {
+		if err := n.NotifyEvent(testGoal()); err != nil {
+			t.Fatalf("NotifyEvent() %d error = %v; want queued", i, err)
+		}
+	}
+	if err := n.NotifyEvent(testGoal()); !errors.Is(err, ErrWebhookQueueFull) {
+		t.Errorf("NotifyEvent() on a full queue error = %v; want ErrWebhookQueueFull", err)
+	}
+
+	close(release)
+	n.Close()
+}
+
+func TestRouterClose(t *testing.T) {
+	server := newWebhookServer(t)
+	n, err := NewWebhookNotifier(data.WebhookTarget{Name: "hook", URL: server.URL, Preset: "slack"}, nil)
+	if err != nil {
+		t.Fatalf("NewWebhookNotifier() error = %v", err)
+	}
+	r := NewRouter(map[string]EventNotifier{ChannelDesktop: &recorder{}, "hook": n})
+	_ = r.Configure(&data.Settings{NotificationRules: []data.NotificationRule{{Name: "Everything", Channels: []string{"hook"}}}})
+
+	if _, err := r.Notify(testGoal()); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	r.Close(5 * time.Second)
+	if len(server.bodies) != 1 {
+		t.Errorf("got %d posts after Close; want the queued one", len(server.bodies))
+	}
+
+	// A stuck webhook doesn't hold up Close past the timeout
+	release := make(chan struct{})
+	stuck := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer func() {
+		close(release)
+		stuck.Close()
+	}()
+	n, err = NewWebhookNotifier(data.WebhookTarget{Name: "stuck", URL: stuck.URL, Preset: "slack"}, nil)
+	if err != nil {
+		t.Fatalf("NewWebhookNotifier() error = %v", err)
+	}
+	_ = n.NotifyEvent(testGoal())
+	start := time.Now()
+	NewRouter(map[string]EventNotifier{"stuck": n}).Close(50 * time.Millisecond)
+	if waited := time.Since(start); waited > time.Second {
+		t.Errorf("Close() waited %v; want about the timeout", waited)
+	}
+}
+
+func TestNewWebhookNotifierErrors(t *testing.T) {
+	tests := []struct {
+		desc   string
+		target data.WebhookTarget
+	}{
+		{"no url", data.WebhookTarget{Name: "a", Preset: "slack"}},
+		{"unknown preset", data.WebhookTarget{Name: "b", URL: "http://localhost", Preset: "teams"}},
+		{"bad template", data.WebhookTarget{Name: "c", URL: "http://localhost", Template: "{{.Title"}},
+	}
+	for _, tt := range tests {
+		if _, err := NewWebhookNotifier(tt.target, nil); err == nil {
+			t.Errorf("%s: NewWebhookNotifier() error = nil; want an error", tt.desc)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// Package standings recalculates league tables from match results,
+// used to project "table if it ended now" while matches are in progress.
+package standings
+
+import (
+	"sort"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// Tiebreaker is a criterion used to order teams level on points.
+type Tiebreaker int
+
+const (
+	GoalDifference Tiebreaker = iota
+	GoalsFor
+	HeadToHead // Points, then goal difference, then goals for among the tied teams
+)
+
+// Rules are the tiebreakers applied in order after points.
+type Rules struct {
+	Tiebreakers []Tiebreaker
+}
+
+// DefaultRules order teams by goal difference, goals for, then head-to-head.
+var DefaultRules = Rules{Tiebreakers: []Tiebreaker{GoalDifference, GoalsFor, HeadToHead}}
+
+// HeadToHeadFirstRules put head-to-head before goal difference.
+var HeadToHeadFirstRules = Rules{Tiebreakers: []Tiebreaker{HeadToHead, GoalDifference, GoalsFor}}
+
+// headToHeadFirstLeagues are competitions that use head-to-head as the first tiebreaker.
+var headToHeadFirstLeagues = map[int]bool{
+	87: true, // La Liga
+	55: true, // Serie A
+	50: true, // UEFA Euro
+}
+
+// RulesForLeague returns the tiebreak rules for a league.
+func RulesForLeague(leagueID int) Rules {
+	if headToHeadFirstLeagues[leagueID] {
+		return HeadToHeadFirstRules
+	}
+	return DefaultRules
+}
+
+// Projection is a table recalculated with in-progress results applied.
+type Projection struct {
+	Entries  []api.LeagueTableEntry
+	Movement map[int]int  // Team ID -> places gained (negative when dropping)
+	Live     map[int]bool // Team IDs currently playing
+}
+
+// Project applies the current scores of live matches to table as if they ended now
+// and re-sorts it with rules. The input table is not modified.
+// Matches involving teams outside the table (e.g., another group) are ignored.
+func Project(table []api.LeagueTableEntry, live []api.Match, rules Rules) Projection {
+	entries := make([]api.LeagueTableEntry, len(table))
+	copy(entries, table)
+
+	index := make(map[int]int, len(entries))
+	for i, entry := range entries {
+		index[entry.Team.ID] = i
+	}
+
+	projection := Projection{
+		Movement: make(map[int]int),
+		Live:     make(map[int]bool),
+	}
+
+	var applied []api.Match
+	for _, match := range live {
+		if match.Status != api.MatchStatusLive || match.HomeScore == nil || match.AwayScore == nil {
+			continue
+		}
+		home, okHome := index[match.HomeTeam.ID]
+		away, okAway := index[match.AwayTeam.ID]
+		if !okHome || !okAway {
+			continue
+		}
+		applyResult(&entries[home], *match.HomeScore, *match.AwayScore)
+		applyResult(&entries[away], *match.AwayScore, *match.HomeScore)
+		projection.Live[match.HomeTeam.ID] = true
+		projection.Live[match.AwayTeam.ID] = true
+		applied = append(applied, match)
+	}
+
+	sourcePoints := make(map[int]int, len(table))
+	for _, entry := range table {
+		sourcePoints[entry.Team.ID] = entry.Points
+	}
+	Sort(entries, applied, rules, sourcePoints)
+
+	for _, entry := range entries {
+		if original := table[index[entry.Team.ID]].Position; original > 0 {
+			projection.Movement[entry.Team.ID] = original - entry.Position
+		}
+	}
+	projection.Entries = entries
+	return projection
+}
+
+// applyResult adds a single result to a team's table entry.
+func applyResult(entry *api.LeagueTableEntry, scored, conceded int) {
+	entry.Played++
+	entry.GoalsFor += scored
+	entry.GoalsAgainst += conceded
+	entry.GoalDifference += scored - conceded
+	switch {
+	case scored > conceded:
+		entry.Won++
+		entry.Points += 3
+	case scored == conceded:
+		entry.Drawn++
+		entry.Points++
+	default:
+		entry.Lost++
+	}
+}
+
+// Sort orders entries by points and then rules, and renumbers their positions.
+// results are the matches available for head-to-head comparisons. When tied teams
+// haven't met in results but were already level on points in the source table
+// (sourcePoints, by team ID; nil when entries are the source table), their existing
+// order stands for the head-to-head step: the source table was ranked with the full
+// season's results, which aren't available here. Teams only level after results were
+// applied move on to the next tiebreaker instead.
+func Sort(entries []api.LeagueTableEntry, results []api.Match, rules Rules, sourcePoints map[int]int) {
+	original := make(map[int]int, len(entries))
+	for i, entry := range entries {
+		original[entry.Team.ID] = i
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Points > entries[j].Points
+	})
+
+	// Break ties within each run of teams level on points
+	for start := 0; start < len(entries); {
+		end := start + 1
+		for end < len(entries) && entries[end].Points == entries[start].Points {
+			end++
+		}
+		if end-start > 1 {
+			sortTied(entries[start:end], results, rules, original, sourcePoints)
+		}
+		start = end
+	}
+
+	for i := range entries {
+		entries[i].Position = i + 1
+	}
+}
+
+// sortTied orders teams level on points using the tiebreakers in order,
+// falling back to their original order.
+func sortTied(tied []api.LeagueTableEntry, results []api.Match, rules Rules, original, sourcePoints map[int]int) {
+	h2h, played := headToHead(tied, results)
+
+	sort.SliceStable(tied, func(i, j int) bool {
+		a, b := tied[i], tied[j]
+		for _, tiebreaker := range rules.Tiebreakers {
+			switch tiebreaker {
+			case GoalDifference:
+				if a.GoalDifference != b.GoalDifference {
+					return a.GoalDifference > b.GoalDifference
+				}
+			case GoalsFor:
+				if a.GoalsFor != b.GoalsFor {
+					return a.GoalsFor > b.GoalsFor
+				}
+			case HeadToHead:
+				if !played {
+					if sourcePoints == nil || sourcePoints[a.Team.ID] == sourcePoints[b.Team.ID] {
+						return original[a.Team.ID] < original[b.Team.ID]
+					}
+					continue
+				}
+				ha, hb := h2h[a.Team.ID], h2h[b.Team.ID]
+				if ha.Points != hb.Points {
+					return ha.Points > hb.Points
+				}
+				if ha.GoalDifference != hb.GoalDifference {
+					return ha.GoalDifference > hb.GoalDifference
+				}
+				if ha.GoalsFor != hb.GoalsFor {
+					return ha.GoalsFor > hb.GoalsFor
+				}
+			}
+		}
+		return original[a.Team.ID] < original[b.Team.ID]
+	})
+}
+
+// headToHead builds a mini-table from the results between the tied teams.
+// Reports whether any such match was found.
+func headToHead(tied []api.LeagueTableEntry, results []api.Match) (map[int]api.LeagueTableEntry, bool) {
+	inGroup := make(map[int]bool, len(tied))
+	for _, entry := range tied {
+		inGroup[entry.Team.ID] = true
+	}
+
+	mini := make(map[int]api.LeagueTableEntry, len(tied))
+	played := false
+	for _, match := range results {
+		if match.HomeScore == nil || match.AwayScore == nil {
+			continue
+		}
+		if !inGroup[match.HomeTeam.ID] || !inGroup[match.AwayTeam.ID] {
+			continue
+		}
+		home, away := mini[match.HomeTeam.ID], mini[match.AwayTeam.ID]
+		applyResult(&home, *match.HomeScore, *match.AwayScore)
+		applyResult(&away, *match.AwayScore, *match.HomeScore)
+		mini[match.HomeTeam.ID], mini[match.AwayTeam.ID] = home, away
+		played = true
+	}
+	return mini, played
+}
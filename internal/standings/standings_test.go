@@ -0,0 +1,177 @@
+package standings
+
+import (
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func entry(pos, id, points, gf, ga int) api.LeagueTableEntry {
+	return api.LeagueTableEntry{
+		Position:       pos,
+		Team:           api.Team{ID: id},
+		Played:         10,
+		GoalsFor:       gf,
+		GoalsAgainst:   ga,
+		GoalDifference: gf - ga,
+		Points:         points,
+	}
+}
+
+func liveMatch(home, away, homeScore, awayScore int) api.Match {
+	return api.Match{
+		HomeTeam:  api.Team{ID: home},
+		AwayTeam:  api.Team{ID: away},
+		Status:    api.MatchStatusLive,
+		HomeScore: &homeScore,
+		AwayScore: &awayScore,
+	}
+}
+
+func order(entries []api.LeagueTableEntry) []int {
+	ids := make([]int, len(entries))
+	for i, e := range entries {
+		ids[i] = e.Team.ID
+	}
+	return ids
+}
+
+func equalOrder(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestProject(t *testing.T) {
+	table := []api.LeagueTableEntry{
+		entry(1, 1, 25, 20, 8),
+		entry(2, 2, 24, 18, 10),
+		entry(3, 3, 22, 15, 12),
+		entry(4, 4, 20, 12, 12),
+	}
+
+	tests := []struct {
+		desc      string
+		live      []api.Match
+		rules     Rules
+		wantOrder []int
+		wantMove  map[int]int
+	}{
+		{
+			desc:      "no live matches keeps order",
+			rules:     DefaultRules,
+			wantOrder: []int{1, 2, 3, 4},
+			wantMove:  map[int]int{1: 0, 2: 0, 3: 0, 4: 0},
+		},
+		{
+			desc:      "win moves team above on points",
+			live:      []api.Match{liveMatch(2, 4, 1, 0)},
+			rules:     DefaultRules,
+			wantOrder: []int{2, 1, 3, 4},
+			wantMove:  map[int]int{1: -1, 2: 1, 3: 0, 4: 0},
+		},
+		{
+			// Team 1 loses 3-0 to team 3: both on 25 pts, team 1 GD +9, team 3 GD +6
+			desc:      "level on points decided by goal difference",
+			live:      []api.Match{liveMatch(3, 1, 3, 0)},
+			rules:     DefaultRules,
+			wantOrder: []int{1, 3, 2, 4},
+			wantMove:  map[int]int{1: 0, 2: -1, 3: 1, 4: 0},
+		},
+		{
+			desc:      "matches outside the table are ignored",
+			live:      []api.Match{liveMatch(3, 99, 5, 0)},
+			rules:     DefaultRules,
+			wantOrder: []int{1, 2, 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		got := Project(table, tt.live, tt.rules)
+		if !equalOrder(order(got.Entries), tt.wantOrder) {
+			t.Errorf("%s: order = %v; want %v", tt.desc, order(got.Entries), tt.wantOrder)
+		}
+		for id, want := range tt.wantMove {
+			if got.Movement[id] != want {
+				t.Errorf("%s: movement[%d] = %d; want %d", tt.desc, id, got.Movement[id], want)
+			}
+		}
+		for i, e := range got.Entries {
+			if e.Position != i+1 {
+				t.Errorf("%s: position of team %d = %d; want %d", tt.desc, e.Team.ID, e.Position, i+1)
+			}
+		}
+	}
+
+	if table[0].Points != 25 || table[0].Played != 10 {
+		t.Errorf("Project modified the input table: %+v", table[0])
+	}
+}
+
+func TestProjectAppliesResult(t *testing.T) {
+	table := []api.LeagueTableEntry{entry(1, 1, 10, 10, 5), entry(2, 2, 10, 8, 8)}
+
+	got := Project(table, []api.Match{liveMatch(1, 2, 2, 2)}, DefaultRules)
+
+	first := got.Entries[0]
+	if first.Team.ID != 1 || first.Played != 11 || first.Drawn != 1 || first.Points != 11 || first.GoalsFor != 12 || first.GoalDifference != 5 {
+		t.Errorf("unexpected projected entry for team 1: %+v", first)
+	}
+	if !got.Live[1] || !got.Live[2] {
+		t.Errorf("Live = %v; want teams 1 and 2 live", got.Live)
+	}
+}
+
+func TestSortTiebreakers(t *testing.T) {
+	// Teams 1 and 2 level on points; team 2 has better goal difference,
+	// team 1 won the head-to-head meeting
+	base := func() []api.LeagueTableEntry {
+		return []api.LeagueTableEntry{entry(1, 1, 20, 15, 10), entry(2, 2, 20, 20, 10)}
+	}
+	h2h := []api.Match{liveMatch(1, 2, 2, 1)}
+
+	tests := []struct {
+		desc    string
+		rules   Rules
+		results []api.Match
+		source  map[int]int // Points in the source table
+		want    []int
+	}{
+		{"goal difference first", DefaultRules, h2h, nil, []int{2, 1}},
+		{"head-to-head first", HeadToHeadFirstRules, h2h, nil, []int{1, 2}},
+		{"head-to-head without meetings keeps the source order", Rules{Tiebreakers: []Tiebreaker{HeadToHead, GoalDifference}}, nil, nil, []int{1, 2}},
+		{"head-to-head without meetings, level in the source", Rules{Tiebreakers: []Tiebreaker{HeadToHead, GoalDifference}}, nil, map[int]int{1: 19, 2: 19}, []int{1, 2}},
+		{"head-to-head without meetings, level only after live results", Rules{Tiebreakers: []Tiebreaker{HeadToHead, GoalDifference}}, nil, map[int]int{1: 20, 2: 19}, []int{2, 1}},
+		{"goals for", Rules{Tiebreakers: []Tiebreaker{GoalsFor}}, nil, nil, []int{2, 1}},
+	}
+
+	for _, tt := range tests {
+		entries := base()
+		Sort(entries, tt.results, tt.rules, tt.source)
+		if !equalOrder(order(entries), tt.want) {
+			t.Errorf("%s: order = %v; want %v", tt.desc, order(entries), tt.want)
+		}
+	}
+
+	// Level only once live results are in, without meeting each other: goal difference decides
+	table := []api.LeagueTableEntry{entry(1, 1, 21, 15, 10), entry(2, 2, 20, 20, 10), entry(3, 3, 10, 5, 10), entry(4, 4, 9, 5, 10)}
+	projected := Project(table, []api.Match{liveMatch(1, 3, 0, 1), liveMatch(2, 4, 0, 0)}, HeadToHeadFirstRules)
+	if got := order(projected.Entries)[:2]; !equalOrder(got, []int{2, 1}) {
+		t.Errorf("newly level teams: order = %v; want [2 1] by goal difference", got)
+	}
+}
+
+func TestRulesForLeague(t *testing.T) {
+	if got := RulesForLeague(87); got.Tiebreakers[0] != HeadToHead {
+		t.Errorf("RulesForLeague(87) first tiebreaker = %v; want HeadToHead", got.Tiebreakers[0])
+	}
+	if got := RulesForLeague(47); got.Tiebreakers[0] != GoalDifference {
+		t.Errorf("RulesForLeague(47) first tiebreaker = %v; want GoalDifference", got.Tiebreakers[0])
+	}
+}
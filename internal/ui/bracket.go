@@ -0,0 +1,370 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Bracket layout dimensions.
+const (
+	bracketTieHeight   = 2  // Home and away line per tie
+	bracketTieSpacing  = 1  // Blank line between ties of the first visible round
+	bracketMinColWidth = 22 // Narrowest round column before rounds are windowed
+	bracketMaxColWidth = 32
+	bracketDetailLines = 3 // Lines reserved below the tree for the selected tie's legs
+)
+
+// bracketConnectorStyle colours the lines joining rounds (no padding, unlike neonSeparatorStyle).
+var bracketConnectorStyle = lipgloss.NewStyle().Foreground(neonRed)
+
+// BracketTieAt returns the tie at flat index idx, counting ties round by round.
+func BracketTieAt(rounds []api.BracketRound, idx int) (api.BracketTie, string, bool) {
+	for _, round := range rounds {
+		if idx < len(round.Ties) {
+			return round.Ties[idx], round.Stage, true
+		}
+		idx -= len(round.Ties)
+	}
+	return api.BracketTie{}, "", false
+}
+
+// BracketTieCount returns the number of ties across all rounds.
+func BracketTieCount(rounds []api.BracketRound) int {
+	count := 0
+	for _, round := range rounds {
+		count += len(round.Ties)
+	}
+	return count
+}
+
+// renderBracket renders the knockout rounds as a tree, one column per round, with the
+// selected tie highlighted and its legs listed underneath.
+// Rounds are windowed horizontally and the tree scrolls vertically to keep the selection visible.
+func renderBracket(rounds []api.BracketRound, selected, contentWidth, height int) string {
+	// Locate the selected tie's round
+	selRound, selTie := 0, selected
+	for selRound < len(rounds)-1 && selTie >= len(rounds[selRound].Ties) {
+		selTie -= len(rounds[selRound].Ties)
+		selRound++
+	}
+
+	// Window rounds so each column gets at least bracketMinColWidth
+	visible := max(min(len(rounds), (contentWidth+2)/(bracketMinColWidth+2)), 1)
+	first := max(min(selRound-visible/2, len(rounds)-visible), 0)
+	shown := rounds[first:min(first+visible, len(rounds))]
+	colWidth := min((contentWidth-2*(len(shown)-1))/len(shown), bracketMaxColWidth)
+
+	// Vertical positions: the first shown round is stacked, later rounds are centred
+	// between their two feeder ties when the round halves, otherwise stacked too
+	positions := make([][]int, len(shown))
+	treeHeight := 0
+	for r, round := range shown {
+		positions[r] = make([]int, len(round.Ties))
+		for i := range round.Ties {
+			if r > 0 && len(round.Ties)*2 >= len(shown[r-1].Ties) && 2*i+1 < len(shown[r-1].Ties) {
+				positions[r][i] = (positions[r-1][2*i] + positions[r-1][2*i+1]) / 2
+			} else {
+				positions[r][i] = i * (bracketTieHeight + bracketTieSpacing)
+			}
+			treeHeight = max(treeHeight, positions[r][i]+bracketTieHeight)
+		}
+	}
+
+	// Draw columns line by line
+	canvas := make([][]string, treeHeight)
+	for y := range canvas {
+		canvas[y] = make([]string, 0, 2*len(shown))
+	}
+	for r, round := range shown {
+		column := make([]string, treeHeight)
+		for y := range column {
+			column[y] = strings.Repeat(" ", colWidth)
+		}
+		for i, tie := range round.Ties {
+			isSelected := first+r == selRound && i == selTie
+			home, away := renderBracketTie(tie, colWidth, isSelected)
+			column[positions[r][i]] = home
+			column[positions[r][i]+1] = away
+		}
+
+		if r > 0 {
+			connector := renderBracketConnectors(positions[r-1], positions[r], treeHeight)
+			for y := range canvas {
+				canvas[y] = append(canvas[y], connector[y])
+			}
+		}
+		for y := range canvas {
+			canvas[y] = append(canvas[y], column[y])
+		}
+	}
+
+	treeLines := make([]string, treeHeight)
+	for y, parts := range canvas {
+		treeLines[y] = strings.Join(parts, "")
+	}
+
+	// Stage headers above each column
+	var headers []string
+	for r, round := range shown {
+		if r > 0 {
+			headers = append(headers, "  ")
+		}
+		headers = append(headers, neonHeaderStyle.Width(colWidth).MaxHeight(1).Render(round.Stage))
+	}
+	header := strings.Join(headers, "")
+	if first > 0 {
+		header = neonDimStyle.Render("◀ ") + header
+	}
+
+	// Scroll the tree to keep the selected tie in view
+	treeArea := max(height-2-bracketDetailLines, bracketTieHeight)
+	offset := 0
+	if selRound >= first && selRound < first+len(shown) && selTie < len(positions[selRound-first]) {
+		y := positions[selRound-first][selTie]
+		if y+bracketTieHeight > treeArea {
+			offset = y + bracketTieHeight - treeArea
+		}
+	}
+	treeLines = treeLines[min(offset, len(treeLines)):]
+	if len(treeLines) > treeArea {
+		treeLines = treeLines[:treeArea]
+	}
+
+	lines := append([]string{header}, treeLines...)
+	lines = append(lines, "")
+	if tie, _, ok := BracketTieAt(rounds, selected); ok {
+		lines = append(lines, renderBracketLegs(tie, contentWidth)...)
+	}
+
+	return truncateToHeight(strings.Join(lines, "\n"), height)
+}
+
+// renderBracketTie renders a tie as two lines: "Team  agg (pens)" for each side, or the
+// kickoff date and time when the tie hasn't started. The winner is highlighted.
+func renderBracketTie(tie api.BracketTie, width int, selected bool) (string, string) {
+	homeName, awayName := bracketTeamName(tie.HomeTeam), bracketTeamName(tie.AwayTeam)
+
+	var homeRight, awayRight string
+	switch {
+	case tie.HomeAggregate != nil && tie.AwayAggregate != nil:
+		homeRight = fmt.Sprintf("%d", *tie.HomeAggregate)
+		awayRight = fmt.Sprintf("%d", *tie.AwayAggregate)
+		if tie.HomePenalties != nil && tie.AwayPenalties != nil {
+			homeRight += fmt.Sprintf(" (%d)", *tie.HomePenalties)
+			awayRight += fmt.Sprintf(" (%d)", *tie.AwayPenalties)
+		}
+	default:
+		if kickoff := nextLegKickoff(tie); kickoff != nil {
+			homeRight = kickoff.Local().Format("02/01")
+			awayRight = kickoff.Local().Format("15:04")
+		}
+	}
+
+	marker := "  "
+	if selected {
+		marker = lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render("▌ ")
+	}
+
+	line := func(team api.Team, name, right string) string {
+		nameStyle := neonValueStyle
+		switch {
+		case team.ID == 0:
+			nameStyle = neonDimStyle
+		case tie.WinnerID == team.ID:
+			nameStyle = neonTeamStyle
+		case tie.WinnerID != 0:
+			nameStyle = neonDimStyle
+		}
+		rightStyle := neonDimStyle
+		if tie.HomeAggregate != nil {
+			rightStyle = neonScoreStyle
+		}
+		return marker + renderTwoColumns(nameStyle.Render(name), rightStyle.Render(right), width-2)
+	}
+
+	return line(tie.HomeTeam, homeName, homeRight), line(tie.AwayTeam, awayName, awayRight)
+}
+
+// renderBracketConnectors draws the lines joining two feeder ties to the tie they lead to.
+// The column is two characters wide and treeHeight lines tall.
+func renderBracketConnectors(prev, next []int, treeHeight int) []string {
+	column := make([]string, treeHeight)
+	for y := range column {
+		column[y] = "  "
+	}
+	for i, target := range next {
+		if 2*i+1 >= len(prev) || target != (prev[2*i]+prev[2*i+1])/2 {
+			continue
+		}
+		top, bottom := prev[2*i], prev[2*i+1]+1
+		for y := top; y <= bottom; y++ {
+			switch {
+			case y == top:
+				column[y] = "┐ "
+			case y == bottom:
+				column[y] = "┘ "
+			case y == target:
+				column[y] = "├─"
+			default:
+				column[y] = "│ "
+			}
+		}
+	}
+	for y := range column {
+		column[y] = bracketConnectorStyle.Render(column[y])
+	}
+	return column
+}
+
+// renderBracketLegs lists the legs of a tie on one line each: "Leg 1  12/03  Home 1-1 Away".
+func renderBracketLegs(tie api.BracketTie, contentWidth int) []string {
+	var lines []string
+	for i, leg := range tie.Legs {
+		label := "Match"
+		if len(tie.Legs) > 1 {
+			label = fmt.Sprintf("Leg %d", i+1)
+		}
+		lines = append(lines, lipgloss.NewStyle().Width(contentWidth).MaxHeight(1).Render(
+			neonDimStyle.Render(label+"  ")+formatBracketLeg(leg)))
+		if len(lines) >= bracketDetailLines {
+			break
+		}
+	}
+	if len(lines) == 0 {
+		lines = append(lines, neonDimStyle.Render("To be decided"))
+	}
+	return lines
+}
+
+// formatBracketLeg formats a leg as "12/03  Home 1-1 Away" or "12/03 20:00  Home vs Away".
+func formatBracketLeg(leg api.Match) string {
+	date := "--/--"
+	if leg.MatchTime != nil {
+		date = leg.MatchTime.Local().Format("02/01")
+	}
+
+	if leg.HomeScore != nil && leg.AwayScore != nil {
+		return neonDimStyle.Render(date+"  ") +
+			neonValueStyle.Render(leg.HomeTeam.Name) + " " +
+			neonScoreStyle.Render(fmt.Sprintf("%d-%d", *leg.HomeScore, *leg.AwayScore)) + " " +
+			neonValueStyle.Render(leg.AwayTeam.Name)
+	}
+
+	if leg.MatchTime != nil {
+		date += leg.MatchTime.Local().Format(" 15:04")
+	}
+	return neonDimStyle.Render(date+"  ") +
+		neonValueStyle.Render(leg.HomeTeam.Name) + neonDimStyle.Render(" vs ") + neonValueStyle.Render(leg.AwayTeam.Name)
+}
+
+// bracketTeamName returns the team's short name, or "TBD" for an undecided slot.
+func bracketTeamName(team api.Team) string {
+	switch {
+	case team.ShortName != "":
+		return team.ShortName
+	case team.Name != "":
+		return team.Name
+	default:
+		return "TBD"
+	}
+}
+
+// nextLegKickoff returns the kickoff of the first leg that hasn't finished.
+func nextLegKickoff(tie api.BracketTie) *time.Time {
+	for _, leg := range tie.Legs {
+		if leg.Status != api.MatchStatusFinished && leg.MatchTime != nil {
+			return leg.MatchTime
+		}
+	}
+	return nil
+}
+
+// RenderTieView renders a knockout tie: the aggregate header, leg tabs and the selected
+// leg's match details.
+func RenderTieView(width, height int, tie *api.BracketTie, stage string, leg int, details *api.MatchDetails, sp spinner.Model, randomSpinner *RandomCharSpinner, loading bool, bannerType constants.StatusBannerType) string {
+	// Handle edge case: if width/height not set, use defaults
+	if width <= 0 {
+		width = 80
+	}
+	if height <= 0 {
+		height = 24
+	}
+
+	// Reserve 3 lines at top for spinner (always reserve to prevent layout shift)
+	spinnerHeight := 3
+	availableHeight := max(height-spinnerHeight, minPanelHeight)
+
+	spinnerStyle := lipgloss.NewStyle().
+		Width(width).
+		Height(spinnerHeight).
+		Align(lipgloss.Center).
+		AlignVertical(lipgloss.Center)
+
+	var spinnerArea string
+	if loading && randomSpinner != nil {
+		spinnerArea = spinnerStyle.Render(randomSpinner.View())
+	} else {
+		spinnerArea = spinnerStyle.Render("")
+	}
+
+	var header, legTabs string
+	if tie != nil {
+		title := bracketTeamName(tie.HomeTeam) + " vs " + bracketTeamName(tie.AwayTeam)
+		if tie.HomeAggregate != nil && tie.AwayAggregate != nil {
+			title += fmt.Sprintf("  •  Agg %d-%d", *tie.HomeAggregate, *tie.AwayAggregate)
+			if tie.HomePenalties != nil && tie.AwayPenalties != nil {
+				title += fmt.Sprintf(" (%d-%d pens)", *tie.HomePenalties, *tie.AwayPenalties)
+			}
+		}
+		header = lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(
+			neonDimStyle.Render(stage+"  ") + neonTeamStyle.Render(title))
+
+		names := make([]string, 0, len(tie.Legs))
+		for i := range tie.Legs {
+			names = append(names, fmt.Sprintf("Leg %d", i+1))
+		}
+		if len(names) > 1 {
+			legTabs = renderTabBar(names, leg, width)
+		}
+	}
+
+	// Leave lines for the header, leg tabs and help text
+	panelHeight := availableHeight - 5
+
+	var panel string
+	if details == nil {
+		message := constants.LoadingFetching
+		if !loading {
+			message = constants.EmptyMatchUnavailable
+		}
+		panel = neonPanelStyle.Width(width).Height(panelHeight).Render(
+			neonEmptyStyle.Width(width - 6).Render(message),
+		)
+	} else {
+		panel = neonPanelStyle.Width(width).Height(panelHeight).Render(
+			renderMatchDetailsPanelFull(width-4, panelHeight-2, details, LiveFeed{}, sp, false, false, nil, false, nil, nil, TimelineFilter{}),
+		)
+	}
+
+	help := lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Foreground(neonDim).
+		Render(constants.HelpTieView)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		spinnerArea,
+		renderStatusBanner(bannerType, width),
+		header,
+		legTabs,
+		panel,
+		help,
+	)
+}
@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// LiveFeed is the feed section of the live match panel: the events timeline
+// and the text commentary, one of which is shown at a time ('c' switches).
+type LiveFeed struct {
+	Updates        []api.TimelineEntry
+	Commentary     []api.CommentaryEntry // Newest first
+	NewCommentary  int                   // Entries at the top of Commentary to highlight
+	ShowCommentary bool
+}
+
+// commentaryMinuteWidth fits the widest minute label ("120+10'").
+const commentaryMinuteWidth = 7
+
+// renderFeedTabs renders the feed title with the active tab highlighted.
+func renderFeedTabs(showCommentary bool) string {
+	active := lipgloss.NewStyle().Foreground(neonCyan).Bold(true)
+	inactive := lipgloss.NewStyle().Foreground(neonDim)
+
+	updates, commentary := active, inactive
+	if showCommentary {
+		updates, commentary = inactive, active
+	}
+	return updates.Render(constants.PanelUpdates) + inactive.Render("  │  ") + commentary.Render(constants.PanelCommentary)
+}
+
+// renderCommentary renders the commentary feed, newest first: the minute, then the text
+// wrapped to the panel. Key moments carry the events timeline's symbol and label,
+// and the lines added by the latest poll are marked with a bar and brighter text.
+func renderCommentary(feed LiveFeed, contentWidth int) []string {
+	minuteStyle := lipgloss.NewStyle().Foreground(neonRed).Bold(true).Width(commentaryMinuteWidth).Align(lipgloss.Right)
+	newMarker := lipgloss.NewStyle().Foreground(neonCyan).Bold(true).Render("▌")
+	textWidth := max(contentWidth-commentaryMinuteWidth-3, 10)
+
+	lines := make([]string, 0, len(feed.Commentary))
+	for i, entry := range feed.Commentary {
+		isNew := i < feed.NewCommentary
+
+		textStyle := lipgloss.NewStyle().Foreground(neonDim).Width(textWidth)
+		if entry.Important || isNew {
+			textStyle = textStyle.Foreground(neonWhite)
+		}
+		if isNew {
+			textStyle = textStyle.Bold(true)
+		}
+
+		text := entry.Text
+		if entry.Important && entry.Kind != api.EventOther {
+			symbol, label := timelineMarker(entry.Kind)
+			text = symbol + " " + label + " " + textStyle.UnsetWidth().Render(text)
+		}
+
+		marker := " "
+		if isNew {
+			marker = newMarker
+		}
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+			marker,
+			minuteStyle.Render(entry.MinuteLabel()),
+			"  ",
+			textStyle.Render(text),
+		))
+	}
+	return lines
+}
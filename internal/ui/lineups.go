@@ -0,0 +1,141 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// renderLineupSection renders formations and starting XIs side by side (home left, away right),
+// followed by the unavailable players for both teams.
+// Returns one string per line so callers can append to scrollable content.
+func renderLineupSection(details *api.MatchDetails, contentWidth int) []string {
+	if details == nil {
+		return nil
+	}
+
+	var lines []string
+
+	if len(details.HomeStarting) > 0 || len(details.AwayStarting) > 0 {
+		header := "Lineups"
+		if details.LineupPredicted {
+			header = "Predicted Lineups"
+		}
+		lines = append(lines, "")
+		lines = append(lines, neonHeaderStyle.Render(header))
+
+		// Formation row
+		if details.HomeFormation != "" || details.AwayFormation != "" {
+			lines = append(lines, renderTwoColumns(
+				neonDimStyle.Render(details.HomeFormation),
+				neonDimStyle.Render(details.AwayFormation),
+				contentWidth,
+			))
+		}
+
+		rows := max(len(details.HomeStarting), len(details.AwayStarting))
+		for i := range rows {
+			var home, away string
+			if i < len(details.HomeStarting) {
+				home = formatLineupPlayer(details.HomeStarting[i])
+			}
+			if i < len(details.AwayStarting) {
+				away = formatLineupPlayer(details.AwayStarting[i])
+			}
+			lines = append(lines, renderTwoColumns(home, away, contentWidth))
+		}
+	}
+
+	lines = append(lines, renderUnavailableSection(details, contentWidth)...)
+
+	return lines
+}
+
+// renderUnavailableSection renders injured and suspended players for both teams side by side.
+// Returns nil when neither team has unavailable players.
+func renderUnavailableSection(details *api.MatchDetails, contentWidth int) []string {
+	if details == nil || (len(details.HomeUnavailable) == 0 && len(details.AwayUnavailable) == 0) {
+		return nil
+	}
+
+	var lines []string
+	lines = append(lines, "")
+	lines = append(lines, neonHeaderStyle.Render("Unavailable"))
+
+	rows := max(len(details.HomeUnavailable), len(details.AwayUnavailable))
+	for i := range rows {
+		var home, away string
+		if i < len(details.HomeUnavailable) {
+			home = formatUnavailablePlayer(details.HomeUnavailable[i])
+		}
+		if i < len(details.AwayUnavailable) {
+			away = formatUnavailablePlayer(details.AwayUnavailable[i])
+		}
+		lines = append(lines, renderTwoColumns(home, away, contentWidth))
+	}
+
+	return lines
+}
+
+// LineupLineCount returns the number of lines renderLineupSection produces for the given details.
+// Used by the app to compute scroll bounds without rendering.
+func LineupLineCount(details *api.MatchDetails) int {
+	if details == nil {
+		return 0
+	}
+
+	count := 0
+	if len(details.HomeStarting) > 0 || len(details.AwayStarting) > 0 {
+		count += 2 // Spacer + header
+		if details.HomeFormation != "" || details.AwayFormation != "" {
+			count++
+		}
+		count += max(len(details.HomeStarting), len(details.AwayStarting))
+	}
+	if len(details.HomeUnavailable) > 0 || len(details.AwayUnavailable) > 0 {
+		count += 2 // Spacer + header
+		count += max(len(details.HomeUnavailable), len(details.AwayUnavailable))
+	}
+	return count
+}
+
+// formatLineupPlayer formats a starting player as "#10 Name".
+func formatLineupPlayer(p api.PlayerInfo) string {
+	if p.Number > 0 {
+		return neonDimStyle.Render(fmt.Sprintf("%2d ", p.Number)) + neonValueStyle.Render(p.Name)
+	}
+	return neonValueStyle.Render(p.Name)
+}
+
+// formatUnavailablePlayer formats an unavailable player as "Name (Injury, Late January)".
+// Suspensions are highlighted in red, injuries and other reasons in yellow.
+func formatUnavailablePlayer(p api.UnavailablePlayer) string {
+	reasonStyle := neonYellowCardStyle
+	if p.Reason == "suspension" || p.Reason == "suspended" {
+		reasonStyle = neonRedCardStyle
+	}
+
+	var detail []string
+	if p.Reason != "" {
+		detail = append(detail, strings.ToUpper(p.Reason[:1])+p.Reason[1:])
+	}
+	if p.ExpectedReturn != "" {
+		detail = append(detail, p.ExpectedReturn)
+	}
+
+	name := neonValueStyle.Render(p.Name)
+	if len(detail) == 0 {
+		return name
+	}
+	return name + " " + reasonStyle.Render("("+strings.Join(detail, ", ")+")")
+}
+
+// renderTwoColumns renders home content left-aligned and away content right-aligned on one line.
+func renderTwoColumns(home, away string, width int) string {
+	colWidth := width / 2
+	left := lipgloss.NewStyle().Width(colWidth).MaxHeight(1).Render(home)
+	right := lipgloss.NewStyle().Width(width - colWidth).MaxHeight(1).Align(lipgloss.Right).Render(away)
+	return left + right
+}
@@ -69,16 +69,23 @@ func NewMatchListDelegate() list.DefaultDelegate {
 
 // LeagueListDelegate is a custom delegate that renders checkboxes separately from titles.
 // This fixes the filter cursor positioning issue by keeping the checkbox out of the title.
+// Used for the settings lists (leagues and followed teams).
 type LeagueListDelegate struct {
 	list.DefaultDelegate
 }
 
-// Render renders a league list item with a checkbox prefix.
+// checkboxItem is a settings list item rendered with a checkbox.
+type checkboxItem interface {
+	list.DefaultItem
+	Checked() bool
+}
+
+// Render renders a league or team list item with a checkbox prefix.
 // The checkbox is rendered separately from the title to prevent filter cursor shift.
 func (d LeagueListDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
-	leagueItem, ok := item.(LeagueListItem)
+	leagueItem, ok := item.(checkboxItem)
 	if !ok {
-		// Fallback: render without checkbox if not a LeagueListItem
+		// Fallback: render without checkbox if not a checkbox item
 		// This shouldn't happen in normal usage, but handle gracefully
 		title := item.FilterValue()
 		desc := ""
@@ -104,7 +111,7 @@ func (d LeagueListDelegate) Render(w io.Writer, m list.Model, index int, item li
 
 	// Get checkbox state
 	checkbox := "[ ]"
-	if leagueItem.Selected {
+	if leagueItem.Checked() {
 		checkbox = "[x]"
 	}
 
@@ -169,7 +176,7 @@ func (d LeagueListDelegate) Render(w io.Writer, m list.Model, index int, item li
 }
 
 // itemMatchesFilter checks if an item matches the filter value.
-func (d LeagueListDelegate) itemMatchesFilter(item LeagueListItem, filterValue string) bool {
+func (d LeagueListDelegate) itemMatchesFilter(item checkboxItem, filterValue string) bool {
 	if filterValue == "" {
 		return true
 	}
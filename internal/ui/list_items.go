@@ -1,8 +1,11 @@
 package ui
 
 import (
+	"strings"
+
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/notify"
 	"github.com/charmbracelet/bubbles/list"
 )
 
@@ -33,6 +36,88 @@ func (l LeagueListItem) FilterValue() string {
 	return l.League.Name + " " + l.League.Country
 }
 
+// Checked reports whether the league is selected, for the checkbox.
+func (l LeagueListItem) Checked() bool {
+	return l.Selected
+}
+
+// TeamListItem implements the list.Item interface for the followed teams tab.
+type TeamListItem struct {
+	Team     data.FollowedTeam
+	Selected bool // Followed
+}
+
+// Title returns the team name.
+func (t TeamListItem) Title() string {
+	return t.Team.Name
+}
+
+// Description returns the team's league.
+func (t TeamListItem) Description() string {
+	return t.Team.League
+}
+
+// FilterValue returns the value used for filtering (team name + league).
+func (t TeamListItem) FilterValue() string {
+	return t.Team.Name + " " + t.Team.League
+}
+
+// Checked reports whether the team is followed, for the checkbox.
+func (t TeamListItem) Checked() bool {
+	return t.Selected
+}
+
+// RuleListItem implements the list.Item interface for the notifications tab.
+type RuleListItem struct {
+	Rule  data.NotificationRule
+	Scope string // The matches the rule covers, e.g., "Followed teams"
+}
+
+// Title returns the rule name and the matches it covers.
+func (r RuleListItem) Title() string {
+	if r.Rule.Name == "" {
+		return r.Scope
+	}
+	return r.Rule.Name + ": " + r.Scope
+}
+
+// Description returns the rule's events, conditions and channels.
+func (r RuleListItem) Description() string {
+	events := "All events"
+	if len(r.Rule.Events) > 0 && len(r.Rule.Events) < len(notify.Kinds) {
+		labels := make([]string, len(r.Rule.Events))
+		for i, event := range r.Rule.Events {
+			labels[i] = notify.Kind(event).Label()
+		}
+		events = strings.Join(labels, ", ")
+	}
+
+	parts := []string{events}
+	if len(r.Rule.When) > 0 {
+		conditions := make([]string, len(r.Rule.When))
+		for i, condition := range r.Rule.When {
+			conditions[i] = notify.ConditionLabel(condition)
+		}
+		parts = append(parts, "when "+strings.Join(conditions, ", "))
+	}
+	channels := r.Rule.Channels
+	if len(channels) == 0 {
+		channels = []string{notify.ChannelDesktop}
+	}
+	parts = append(parts, strings.Join(channels, ", "))
+	return strings.Join(parts, " · ")
+}
+
+// FilterValue returns the value used for filtering (the title).
+func (r RuleListItem) FilterValue() string {
+	return r.Title()
+}
+
+// Checked reports whether the rule is enabled, for the checkbox.
+func (r RuleListItem) Checked() bool {
+	return !r.Rule.Disabled
+}
+
 // Title returns the match title for the list item.
 func (m MatchListItem) Title() string {
 	return m.Display.Title()
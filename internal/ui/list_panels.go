@@ -41,7 +41,8 @@ func (g GoalLinksMap) GetReplayURL(matchID, minute int) string {
 // Note: listModel is passed by value, so SetSize must be called before this function.
 // Uses Neon design with Golazo red/cyan theme.
 // upcomingMatches are displayed at the bottom of the panel (fixed, not scrollable).
-func RenderLiveMatchesListPanel(width, height int, listModel list.Model, upcomingMatches []MatchDisplay) string {
+// upcomingSelected highlights an upcoming match when that section has focus (-1 = none).
+func RenderLiveMatchesListPanel(width, height int, listModel list.Model, upcomingMatches []MatchDisplay, upcomingSelected int) string {
 	contentWidth := width - 6 // Account for border and padding
 
 	// Wrap list in panel with neon styling
@@ -70,11 +71,11 @@ func RenderLiveMatchesListPanel(width, height int, listModel list.Model, upcomin
 		// Render upcoming section header
 		upcomingTitle := neonHeaderStyle.Render("Upcoming")
 
-		// Render upcoming matches as simple text (not selectable)
+		// Render upcoming matches as simple text, highlighting the selection when focused
 		var upcomingLines []string
 		upcomingLines = append(upcomingLines, upcomingTitle)
-		for _, match := range upcomingMatches {
-			matchLine := renderUpcomingMatchLine(match, contentWidth)
+		for i, match := range upcomingMatches {
+			matchLine := renderUpcomingMatchLine(match, contentWidth, i == upcomingSelected)
 			upcomingLines = append(upcomingLines, matchLine)
 		}
 		upcomingSection = strings.Join(upcomingLines, "\n")
@@ -131,12 +132,16 @@ func RenderLiveMatchesListPanel(width, height int, listModel list.Model, upcomin
 }
 
 // renderUpcomingMatchLine renders a single upcoming match as a simple text line.
-func renderUpcomingMatchLine(match MatchDisplay, maxWidth int) string {
+// The selected line gets a red marker and highlighted team names.
+func renderUpcomingMatchLine(match MatchDisplay, maxWidth int, selected bool) string {
 	// Format: "  HH:MM  Team A vs Team B"
 	var timeStr string
-	if match.MatchTime != nil {
+	switch {
+	case match.Status == api.MatchStatusPostponed:
+		timeStr = "PPD  "
+	case match.MatchTime != nil:
 		timeStr = match.MatchTime.Local().Format("15:04")
-	} else {
+	default:
 		timeStr = "--:--"
 	}
 
@@ -160,8 +165,17 @@ func renderUpcomingMatchLine(match MatchDisplay, maxWidth int) string {
 
 	timeStyle := neonDimStyle
 	teamStyle := neonValueStyle
+	marker := "  "
+	switch {
+	case selected:
+		teamStyle = neonTeamStyle
+		marker = lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render("▌ ")
+	case match.Followed:
+		marker = lipgloss.NewStyle().Foreground(neonCyan).Render(FollowedMarker + " ")
+	}
 
-	return fmt.Sprintf("  %s  %s vs %s",
+	return fmt.Sprintf("%s%s  %s vs %s",
+		marker,
 		timeStyle.Render(timeStr),
 		teamStyle.Render(homeTeam),
 		teamStyle.Render(awayTeam))
@@ -285,7 +299,9 @@ func renderDateRangeSelector(width int, selected int) string {
 // leaguesLoaded and totalLeagues show loading progress during progressive loading.
 // pollingSpinner and isPolling control the small polling indicator in the right panel.
 // upcomingMatches are displayed at the bottom of the left panel (fixed, not scrollable).
-func RenderMultiPanelViewWithList(width, height int, listModel list.Model, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, randomSpinner *RandomCharSpinner, viewLoading bool, leaguesLoaded int, totalLeagues int, pollingSpinner *RandomCharSpinner, isPolling bool, upcomingMatches []MatchDisplay, goalLinks GoalLinksMap, bannerType constants.StatusBannerType) string {
+// upcomingSelected is the focused upcoming match (-1 = live list focused); previewTable
+// provides league positions for the pre-match preview of not-started matches.
+func RenderMultiPanelViewWithList(width, height int, listModel list.Model, details *api.MatchDetails, feed LiveFeed, sp spinner.Model, loading bool, randomSpinner *RandomCharSpinner, viewLoading bool, leaguesLoaded int, totalLeagues int, pollingSpinner *RandomCharSpinner, isPolling bool, upcomingMatches []MatchDisplay, upcomingSelected int, previewTable []api.LeagueTableEntry, goalLinks GoalLinksMap, bannerType constants.StatusBannerType, filter TimelineFilter) string {
 	// Handle edge case: if width/height not set, use defaults
 	if width <= 0 {
 		width = 80
@@ -339,10 +355,10 @@ func RenderMultiPanelViewWithList(width, height int, listModel list.Model, detai
 
 	// Render left panel (matches list) - shifted down
 	// Upcoming matches are displayed at the bottom of the left panel
-	leftPanel := RenderLiveMatchesListPanel(leftWidth, panelHeight, listModel, upcomingMatches)
+	leftPanel := RenderLiveMatchesListPanel(leftWidth, panelHeight, listModel, upcomingMatches, upcomingSelected)
 
-	// Render right panel (match details with live updates) - shifted down
-	rightPanel := renderMatchDetailsPanelWithPolling(rightWidth, panelHeight, details, liveUpdates, sp, loading, pollingSpinner, isPolling, goalLinks)
+	// Render right panel (match details with live updates, or preview for upcoming) - shifted down
+	rightPanel := renderMatchDetailsPanelWithPolling(rightWidth, panelHeight, details, feed, sp, loading, pollingSpinner, isPolling, goalLinks, previewTable, filter)
 
 	// Create separator with neon red accent
 	separatorStyle := neonSeparatorStyle.Height(panelHeight)
@@ -374,7 +390,7 @@ func RenderMultiPanelViewWithList(width, height int, listModel list.Model, detai
 // Rebuilt to match live view structure exactly: spinner at top, left panel (matches), right panel (details).
 // daysLoaded and totalDays show loading progress during progressive loading.
 // Note: Upcoming matches are now shown in the Live view instead.
-func RenderStatsViewWithList(width, height int, finishedList list.Model, details *api.MatchDetails, randomSpinner *RandomCharSpinner, viewLoading bool, dateRange int, daysLoaded int, totalDays int, goalLinks GoalLinksMap, bannerType constants.StatusBannerType, detailsViewport *viewport.Model, rightPanelFocused bool, scrollOffset int) string {
+func RenderStatsViewWithList(width, height int, finishedList list.Model, details *api.MatchDetails, randomSpinner *RandomCharSpinner, viewLoading bool, dateRange int, daysLoaded int, totalDays int, goalLinks GoalLinksMap, bannerType constants.StatusBannerType, detailsViewport *viewport.Model, rightPanelFocused bool, scrollOffset int, filter TimelineFilter) string {
 	// Handle edge case: if width/height not set, use defaults
 	if width <= 0 {
 		width = 80
@@ -430,7 +446,7 @@ func RenderStatsViewWithList(width, height int, finishedList list.Model, details
 	leftPanel := RenderStatsListPanel(leftWidth, panelHeight, finishedList, dateRange, rightPanelFocused)
 
 	// Render right panel (match details) - split into fixed header and scrollable content
-	headerContent, scrollableContent := renderStatsMatchDetailsPanel(rightWidth, panelHeight, details, goalLinks, rightPanelFocused)
+	headerContent, scrollableContent := renderStatsMatchDetailsPanel(rightWidth, panelHeight, details, goalLinks, rightPanelFocused, filter)
 
 	var rightPanel string
 
@@ -527,7 +543,7 @@ func RenderStatsViewWithList(width, height int, finishedList list.Model, details
 // Uses Neon design with Golazo red/cyan theme.
 // Returns fixed header and scrollable content separately for viewport scrolling.
 // Displays expanded match information including statistics, lineups, and more.
-func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails, goalLinks GoalLinksMap, focused bool) (string, string) {
+func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails, goalLinks GoalLinksMap, focused bool, filter TimelineFilter) (string, string) {
 	if details == nil {
 		emptyMessage := neonDimStyle.
 			Align(lipgloss.Center).
@@ -599,6 +615,9 @@ func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails,
 	if details.HomeScore != nil && details.AwayScore != nil {
 		largeScore := renderLargeScore(*details.HomeScore, *details.AwayScore, contentWidth)
 		headerLines = append(headerLines, largeScore)
+		if scoreContext := renderScoreContext(details, contentWidth); scoreContext != "" {
+			headerLines = append(headerLines, scoreContext)
+		}
 	} else {
 		vsText := lipgloss.NewStyle().
 			Foreground(neonDim).
@@ -625,18 +644,37 @@ func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails,
 	if details.Attendance > 0 {
 		headerLines = append(headerLines, neonLabelStyle.Render("Attendance:  ")+neonValueStyle.Render(formatNumber(details.Attendance)))
 	}
+	if filterBar := renderTimelineFilterBar(filter, contentWidth); filterBar != "" {
+		headerLines = append(headerLines, "", filterBar)
+	}
+
+	// ═══════════════════════════════════════════════
+	// FILTERED TIMELINE (replaces goals and cards while a filter is active)
+	// ═══════════════════════════════════════════════
+	if filter.Active() {
+		scrollableLines = append(scrollableLines, "")
+		scrollableLines = append(scrollableLines, neonHeaderStyle.Render("Timeline"))
+
+		events := filterEvents(details.Events, filter, func(api.MatchEvent) bool { return true })
+		if len(events) == 0 {
+			scrollableLines = append(scrollableLines, neonDimStyle.Render(constants.EmptyNoMatchingEvents))
+		}
+		for _, event := range events {
+			scrollableLines = append(scrollableLines, formatMatchEventForDisplay(event, details.HomeTeam.ID, contentWidth))
+		}
+	}
 
 	// ═══════════════════════════════════════════════
 	// GOALS TIMELINE (chronological with home/away alignment)
 	// ═══════════════════════════════════════════════
 	var goals []api.MatchEvent
 	for _, event := range details.Events {
-		if event.Type == "goal" {
+		if event.Kind.IsGoal() {
 			goals = append(goals, event)
 		}
 	}
 
-	if len(goals) > 0 {
+	if len(goals) > 0 && !filter.Active() {
 		scrollableLines = append(scrollableLines, "")
 		scrollableLines = append(scrollableLines, neonHeaderStyle.Render("Goals"))
 
@@ -652,7 +690,7 @@ func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails,
 			// Check for replay link and create indicator
 			replayIndicator := getReplayIndicator(details, goalLinks, g.Minute)
 
-			goalContent := buildEventContent(playerDetails, replayIndicator, "●", neonScoreStyle.Render("GOAL"), isHome)
+			goalContent := buildEventContent(playerDetails, replayIndicator, TimelineSymbolGoal, neonScoreStyle.Render(g.Kind.Label()), isHome)
 			minuteStr := g.DisplayMinute
 			if minuteStr == "" {
 				minuteStr = fmt.Sprintf("%d'", g.Minute) // Fallback
@@ -662,17 +700,25 @@ func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails,
 		}
 	}
 
+	// ═══════════════════════════════════════════════
+	// PENALTY SHOOTOUT
+	// ═══════════════════════════════════════════════
+	if shootout := renderShootout(details, contentWidth); len(shootout) > 0 {
+		scrollableLines = append(scrollableLines, "")
+		scrollableLines = append(scrollableLines, shootout...)
+	}
+
 	// ═══════════════════════════════════════════════
 	// CARDS - Detailed list with player, minute (aligned by team)
 	// ═══════════════════════════════════════════════
 	var cardEvents []api.MatchEvent
 	for _, event := range details.Events {
-		if event.Type == "card" {
+		if event.Kind.IsCard() {
 			cardEvents = append(cardEvents, event)
 		}
 	}
 
-	if len(cardEvents) > 0 {
+	if len(cardEvents) > 0 && !filter.Active() {
 		scrollableLines = append(scrollableLines, "")
 		scrollableLines = append(scrollableLines, neonHeaderStyle.Render("Cards"))
 
@@ -686,14 +732,14 @@ func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails,
 			// Determine card type and apply appropriate color (using shared styles)
 			cardSymbol := CardSymbolYellow
 			cardStyle := neonYellowCardStyle
-			if card.EventType != nil && (*card.EventType == "red" || *card.EventType == "redcard" || *card.EventType == "secondyellow") {
+			if card.Kind.IsSendingOff() {
 				cardSymbol = CardSymbolRed
 				cardStyle = neonRedCardStyle
 			}
 
 			// Build card content with symbol+type adjacent to center time
 			playerDetails := neonValueStyle.Render(player)
-			cardContent := buildEventContent(playerDetails, "", cardSymbol, cardStyle.Render("CARD"), isHome)
+			cardContent := buildEventContent(playerDetails, "", cardSymbol, cardStyle.Render(card.Kind.Label()), isHome)
 			minuteStr := card.DisplayMinute
 			if minuteStr == "" {
 				minuteStr = fmt.Sprintf("%d'", card.Minute) // Fallback
@@ -758,6 +804,11 @@ func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails,
 		}
 	}
 
+	// ═══════════════════════════════════════════════
+	// LINEUPS & UNAVAILABLE PLAYERS
+	// ═══════════════════════════════════════════════
+	scrollableLines = append(scrollableLines, renderLineupSection(details, contentWidth)...)
+
 	// Combine header and scrollable content
 	headerContent := lipgloss.JoinVertical(lipgloss.Left, headerLines...)
 	scrollableContent := lipgloss.JoinVertical(lipgloss.Left, scrollableLines...)
@@ -768,7 +819,7 @@ func renderStatsMatchDetailsPanel(width, height int, details *api.MatchDetails,
 // RenderMatchDetailsPanel is an exported version of renderStatsMatchDetailsPanel
 // for use by debug scripts. Renders match details in the Golazo stats view style.
 func RenderMatchDetailsPanel(width, height int, details *api.MatchDetails) string {
-	header, scrollable := renderStatsMatchDetailsPanel(width, height, details, nil, false)
+	header, scrollable := renderStatsMatchDetailsPanel(width, height, details, nil, false, TimelineFilter{})
 	content := lipgloss.JoinVertical(lipgloss.Left, header, scrollable)
 	return neonPanelCyanStyle.
 		Width(width).
@@ -3,6 +3,7 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
 )
@@ -10,9 +11,13 @@ import (
 // MatchDisplay wraps a match with display information for rendering.
 type MatchDisplay struct {
 	api.Match
+	Followed bool // A followed team plays: pinned to the top of lists and marked
 }
 
-// Title returns a formatted title for the match.
+// FollowedMarker marks matches of followed teams in match lists.
+const FollowedMarker = "★"
+
+// Title returns a formatted title for the match, marked when a followed team plays.
 func (m MatchDisplay) Title() string {
 	home := m.HomeTeam.ShortName
 	if home == "" {
@@ -22,11 +27,14 @@ func (m MatchDisplay) Title() string {
 	if away == "" {
 		away = m.AwayTeam.Name
 	}
+	if m.Followed {
+		return FollowedMarker + " " + home + " vs " + away
+	}
 	return home + " vs " + away
 }
 
 // Description returns a formatted description for the match.
-// Shows score, league, live time on first line; KO time on second line.
+// Shows score, aggregate (second legs), league, clock or phase on first line; KO time on second line.
 func (m MatchDisplay) Description() string {
 	var parts []string
 
@@ -35,14 +43,19 @@ func (m MatchDisplay) Description() string {
 		parts = append(parts, fmt.Sprintf("%d - %d", *m.HomeScore, *m.AwayScore))
 	}
 
+	// Add aggregate for the second leg of a two-legged tie
+	if m.HomeAggregate != nil && m.AwayAggregate != nil {
+		parts = append(parts, fmt.Sprintf("Agg %d - %d", *m.HomeAggregate, *m.AwayAggregate))
+	}
+
 	// Add league name
 	if m.League.Name != "" {
 		parts = append(parts, m.League.Name)
 	}
 
-	// Add live time
-	if m.LiveTime != nil {
-		parts = append(parts, *m.LiveTime)
+	// Add match clock or phase (e.g., "67'", "HT", "AET", "Postponed"), running between polls
+	if label := m.StateAt(time.Now()).Label(); label != "" {
+		parts = append(parts, label)
 	}
 
 	line1 := strings.Join(parts, " • ")
@@ -54,3 +67,20 @@ func (m MatchDisplay) Description() string {
 
 	return line1
 }
+
+// PinFollowed flags the matches of followed teams and moves them to the top, keeping the order otherwise.
+// With onlyFollowed (the "My teams" filter), the other matches are left out.
+func PinFollowed(matches []MatchDisplay, followed map[int]bool, onlyFollowed bool) []MatchDisplay {
+	pinned := make([]MatchDisplay, 0, len(matches))
+	var others []MatchDisplay
+	for _, match := range matches {
+		match.Followed = followed[match.HomeTeam.ID] || followed[match.AwayTeam.ID]
+		switch {
+		case match.Followed:
+			pinned = append(pinned, match)
+		case !onlyFollowed:
+			others = append(others, match)
+		}
+	}
+	return append(pinned, others...)
+}
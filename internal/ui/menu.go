@@ -44,6 +44,7 @@ func RenderMainMenu(width, height, selected int, sp spinner.Model, randomSpinner
 	menuItems := []string{
 		constants.MenuStats,
 		constants.MenuLiveMatches,
+		constants.MenuStandings,
 		constants.MenuSettings,
 	}
 
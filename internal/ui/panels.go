@@ -2,8 +2,8 @@ package ui
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
+	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/constants"
@@ -150,18 +150,19 @@ func isHomeTeamEvent(event api.MatchEvent, homeTeamID int) bool {
 }
 
 // renderMatchDetailsPanel renders the right panel with match details and live updates.
-func renderMatchDetailsPanel(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool) string {
-	return renderMatchDetailsPanelFull(width, height, details, liveUpdates, sp, loading, true, nil, false, nil)
+func renderMatchDetailsPanel(width, height int, details *api.MatchDetails, feed LiveFeed, sp spinner.Model, loading bool) string {
+	return renderMatchDetailsPanelFull(width, height, details, feed, sp, loading, true, nil, false, nil, nil, TimelineFilter{})
 }
 
 // renderMatchDetailsPanelWithPolling renders the right panel with polling spinner support.
-func renderMatchDetailsPanelWithPolling(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
-	return renderMatchDetailsPanelFull(width, height, details, liveUpdates, sp, loading, true, pollingSpinner, isPolling, goalLinks)
+// previewTable supplies league positions for the pre-match preview (may be nil).
+func renderMatchDetailsPanelWithPolling(width, height int, details *api.MatchDetails, feed LiveFeed, sp spinner.Model, loading bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap, previewTable []api.LeagueTableEntry, filter TimelineFilter) string {
+	return renderMatchDetailsPanelFull(width, height, details, feed, sp, loading, true, pollingSpinner, isPolling, goalLinks, previewTable, filter)
 }
 
 // renderMatchDetailsPanelFull renders the right panel with optional title and polling spinner.
 // Uses Neon design with Golazo red/cyan theme.
-func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, showTitle bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
+func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, feed LiveFeed, sp spinner.Model, loading bool, showTitle bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap, previewTable []api.LeagueTableEntry, filter TimelineFilter) string {
 	// Use consolidated neon colors from neon_styles.go
 
 	// Details panel - no border, just padding for clean look
@@ -205,15 +206,23 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 	// 1. Status/Minute and League info (centered)
 	infoStyle := lipgloss.NewStyle().Foreground(neonDim)
 	var statusText string
+	label := details.StateAt(time.Now()).Label() // Clock runs between polls
 	switch details.Status {
 	case api.MatchStatusLive:
-		liveTime := constants.StatusLive
-		if details.LiveTime != nil {
-			liveTime = *details.LiveTime
+		if label == "" {
+			label = constants.StatusLive
 		}
-		statusText = lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render(liveTime)
+		statusText = lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render(label)
 	case api.MatchStatusFinished:
-		statusText = lipgloss.NewStyle().Foreground(neonCyan).Render(constants.StatusFinished)
+		if label == "" {
+			label = constants.StatusFinished
+		}
+		statusText = lipgloss.NewStyle().Foreground(neonCyan).Render(label)
+	case api.MatchStatusPostponed, api.MatchStatusCancelled:
+		if label == "" {
+			label = string(details.Status)
+		}
+		statusText = infoStyle.Render(label)
 	default:
 		statusText = infoStyle.Render(constants.StatusNotStartedShort)
 	}
@@ -245,6 +254,10 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 	if details.HomeScore != nil && details.AwayScore != nil {
 		largeScore := renderLargeScore(*details.HomeScore, *details.AwayScore, contentWidth)
 		content.WriteString(largeScore)
+		if scoreContext := renderScoreContext(details, contentWidth); scoreContext != "" {
+			content.WriteString("\n")
+			content.WriteString(scoreContext)
+		}
 	} else {
 		vsText := lipgloss.NewStyle().
 			Foreground(neonDim).
@@ -256,6 +269,12 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 	}
 	content.WriteString("\n\n")
 
+	// Timeline filter toggles, player search and jump-to-minute (when in use)
+	if filterBar := renderTimelineFilterBar(filter, contentWidth); filterBar != "" {
+		content.WriteString(filterBar)
+		content.WriteString("\n\n")
+	}
+
 	// For finished matches, show detailed match information
 	// For live matches, show live updates
 	if details.Status == api.MatchStatusFinished {
@@ -277,10 +296,6 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 		if details.ExtraTime {
 			infoSection = append(infoSection, infoStyle.Render("AET"))
 		}
-		if details.Penalties != nil && details.Penalties.Home != nil && details.Penalties.Away != nil {
-			penText := fmt.Sprintf("Pens: %d - %d", *details.Penalties.Home, *details.Penalties.Away)
-			infoSection = append(infoSection, infoStyle.Render(penText))
-		}
 
 		if len(infoSection) > 0 {
 			content.WriteString(strings.Join(infoSection, " | "))
@@ -288,12 +303,7 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 		}
 
 		// Goals Timeline section with neon styling
-		var goals []api.MatchEvent
-		for _, event := range details.Events {
-			if event.Type == "goal" {
-				goals = append(goals, event)
-			}
-		}
+		goals := filterEvents(details.Events, filter, func(e api.MatchEvent) bool { return e.Kind.IsGoal() })
 
 		if len(goals) > 0 {
 			goalsTitle := lipgloss.NewStyle().
@@ -322,7 +332,7 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 				replayIndicator := getReplayIndicator(details, goalLinks, goal.Minute)
 
 				goalStyle := lipgloss.NewStyle().Foreground(neonRed).Bold(true)
-				goalContent := buildEventContent(playerDetails, replayIndicator, "●", goalStyle.Render("GOAL"), isHome)
+				goalContent := buildEventContent(playerDetails, replayIndicator, TimelineSymbolGoal, goalStyle.Render(goal.Kind.Label()), isHome)
 
 				minuteStr := goal.DisplayMinute
 				if minuteStr == "" {
@@ -335,14 +345,15 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 			content.WriteString("\n")
 		}
 
-		// Cards section with neon styling - detailed list with player, minute, team
-		var cardEvents []api.MatchEvent
-		for _, event := range details.Events {
-			if event.Type == "card" {
-				cardEvents = append(cardEvents, event)
-			}
+		// Penalty shootout, kick by kick
+		if shootout := renderShootout(details, contentWidth); len(shootout) > 0 {
+			content.WriteString(strings.Join(shootout, "\n"))
+			content.WriteString("\n\n")
 		}
 
+		// Cards section with neon styling - detailed list with player, minute, team
+		cardEvents := filterEvents(details.Events, filter, func(e api.MatchEvent) bool { return e.Kind.IsCard() })
+
 		if len(cardEvents) > 0 {
 			cardsTitle := lipgloss.NewStyle().
 				Foreground(neonCyan).
@@ -366,14 +377,14 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 				// Determine card type and apply appropriate color
 				cardSymbol := CardSymbolYellow
 				cardStyle := neonYellowCardStyle
-				if card.EventType != nil && *card.EventType == "red" {
+				if card.Kind.IsSendingOff() {
 					cardSymbol = CardSymbolRed
 					cardStyle = neonRedCardStyle
 				}
 
 				// Build content with symbol+type adjacent to center time
 				playerDetails := lipgloss.NewStyle().Foreground(neonWhite).Render(player)
-				cardContent := buildEventContent(playerDetails, "", cardSymbol, cardStyle.Render("CARD"), isHome)
+				cardContent := buildEventContent(playerDetails, "", cardSymbol, cardStyle.Render(card.Kind.Label()), isHome)
 
 				minuteStr := card.DisplayMinute
 				if minuteStr == "" {
@@ -400,22 +411,36 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 		content.WriteString("\n")
 
 		// Display match events (goals, cards, substitutions)
-		if len(details.Events) == 0 {
+		events := filterEvents(details.Events, filter, func(api.MatchEvent) bool { return true })
+		if len(events) == 0 {
+			emptyText := "No events recorded"
+			if len(details.Events) > 0 {
+				emptyText = constants.EmptyNoMatchingEvents
+			}
 			emptyEvents := lipgloss.NewStyle().
 				Foreground(neonDim).
 				Padding(0, 0).
-				Render("No events recorded")
+				Render(emptyText)
 			content.WriteString(emptyEvents)
 		} else {
 			// Show events in chronological order (oldest first)
 			var eventsList []string
-			for _, event := range details.Events {
+			for _, event := range events {
 				eventLine := formatMatchEventForDisplay(event, details.HomeTeam.ID, contentWidth)
 				eventsList = append(eventsList, eventLine)
 			}
 			content.WriteString(strings.Join(eventsList, "\n"))
 		}
+	} else if details.Status == api.MatchStatusNotStarted || details.Status == api.MatchStatusPostponed {
+		// Before kickoff (or when postponed), show the pre-match preview instead of (empty) live updates
+		content.WriteString(strings.Join(renderMatchPreviewSection(details, previewTable, contentWidth, time.Now()), "\n"))
 	} else {
+		// A shootout in progress is shown above the live updates
+		if shootout := renderShootout(details, contentWidth); len(shootout) > 0 {
+			content.WriteString(strings.Join(shootout, "\n"))
+			content.WriteString("\n\n")
+		}
+
 		// Live Updates section for live/upcoming matches with neon styling
 		// Build title - show "Updating..." with spinner only during poll API calls
 		var titleText string
@@ -424,8 +449,8 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 			pollingView := pollingSpinner.View()
 			titleText = "Updating...  " + pollingView
 		} else {
-			// Not polling or not loading - just show "Updates"
-			titleText = constants.PanelUpdates
+			// Not polling or not loading - show the feed tabs (Updates / Commentary)
+			titleText = renderFeedTabs(feed.ShowCommentary)
 		}
 		updatesTitle := lipgloss.NewStyle().
 			Foreground(neonCyan).
@@ -439,21 +464,32 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 		content.WriteString(updatesTitle)
 		content.WriteString("\n")
 
-		// Display live updates (already sorted by minute descending - newest first)
-		if len(liveUpdates) == 0 && !loading && !isPolling {
-			emptyUpdates := lipgloss.NewStyle().
-				Foreground(neonDim).
-				Padding(0, 0).
-				Render(constants.EmptyNoUpdates)
-			content.WriteString(emptyUpdates)
-		} else if len(liveUpdates) > 0 {
-			// Events are already sorted descending by minute
+		if feed.ShowCommentary {
+			// Text commentary, newest first with the latest poll's lines highlighted
+			if len(feed.Commentary) == 0 && !loading && !isPolling {
+				content.WriteString(lipgloss.NewStyle().Foreground(neonDim).Render(constants.EmptyNoCommentary))
+			} else {
+				content.WriteString(strings.Join(renderCommentary(feed, contentWidth), "\n"))
+			}
+		} else {
+			// Display live updates (already sorted by minute descending - newest first)
 			var updatesList []string
-			for _, update := range liveUpdates {
-				updateLine := renderStyledLiveUpdate(update, contentWidth, details, goalLinks)
-				updatesList = append(updatesList, updateLine)
+			for _, update := range feed.Updates {
+				if filter.MatchesEntry(update) {
+					updatesList = append(updatesList, renderStyledLiveUpdate(update, contentWidth, details, goalLinks))
+				}
+			}
+			if len(updatesList) == 0 && len(feed.Updates) > 0 {
+				content.WriteString(lipgloss.NewStyle().Foreground(neonDim).Render(constants.EmptyNoMatchingEvents))
+			} else if len(feed.Updates) == 0 && !loading && !isPolling {
+				emptyUpdates := lipgloss.NewStyle().
+					Foreground(neonDim).
+					Padding(0, 0).
+					Render(constants.EmptyNoUpdates)
+				content.WriteString(emptyUpdates)
+			} else if len(updatesList) > 0 {
+				content.WriteString(strings.Join(updatesList, "\n"))
 			}
-			content.WriteString(strings.Join(updatesList, "\n"))
 		}
 	}
 
@@ -476,233 +512,90 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 	return panel
 }
 
-// extractTeamMarker extracts the [H] or [A] marker from the end of an update string.
-// Returns the cleaned update string (without marker) and whether it's a home team event.
-func extractTeamMarker(update string) (string, bool) {
-	if before, ok := strings.CutSuffix(update, " [H]"); ok {
-		return before, true
-	}
-	if before, ok := strings.CutSuffix(update, " [A]"); ok {
-		return before, false
-	}
-	// Default to home if no marker found
-	return update, true
-}
-
-// extractMinuteFromUpdate extracts the minute string (e.g., "45'") from a live update.
-// Format: "● 45' [GOAL] Player" -> returns "45'" and "● [GOAL] Player"
-func extractMinuteFromUpdate(update string) (minute string, rest string) {
-	// Find the minute pattern: space + digits + apostrophe + space
-	// e.g., " 45' " or " 90' "
-	parts := strings.SplitN(update, "' ", 2)
-	if len(parts) != 2 {
-		return "", update
-	}
-
-	// Find the last space before the minute
-	firstPart := parts[0]
-	lastSpace := strings.LastIndex(firstPart, " ")
-	if lastSpace == -1 {
-		return "", update
-	}
-
-	minute = firstPart[lastSpace+1:] + "'"
-	prefix := firstPart[:lastSpace]
-	rest = prefix + " " + parts[1]
-
-	return minute, rest
-}
+// Timeline event symbols, placed next to the label closest to the center time.
+const (
+	TimelineSymbolGoal          = "●" // Goals, own goals and penalties
+	TimelineSymbolYellowCard    = "▪"
+	TimelineSymbolRedCard       = "■" // Red card or second yellow
+	TimelineSymbolSubstitution  = "↔"
+	TimelineSymbolMissedPenalty = "✕"
+	TimelineSymbolVAR           = "◇"
+	TimelineSymbolOther         = "·"
+)
 
-// formatMatchEventForDisplay formats a match event for display in the stats view
-// Uses neon styling with red/cyan theme and no emojis
-// renderStyledLiveUpdate renders a live update string with appropriate colors based on symbol prefix.
-// Uses minimal symbol styling: ● gradient for goals, ▪ cyan for yellow cards, ■ red for red cards,
-// ↔ dim for substitutions, · dim for other events.
+// renderStyledLiveUpdate renders a timeline entry with styling based on its kind.
+// Uses minimal symbol styling: ● gradient for goals, ▪ yellow for yellow cards, ■ red for red cards,
+// ↔ dim for substitutions, ✕ dim for missed penalties, ◇ cyan for VAR, · dim for other events.
 // Applies center-aligned timeline with time in middle, symbol+type adjacent to center.
-func renderStyledLiveUpdate(update string, contentWidth int, details *api.MatchDetails, goalLinks GoalLinksMap) string {
-	if len(update) == 0 {
-		return update
-	}
-
-	// Extract team marker for alignment
-	cleanUpdate, isHome := extractTeamMarker(update)
-
-	// Extract minute from the update string
-	minute, contentWithoutMinute := extractMinuteFromUpdate(cleanUpdate)
-	if minute == "" {
-		minute = "0'"
-		contentWithoutMinute = cleanUpdate
-	}
-
-	// Get the first rune (symbol prefix)
-	runes := []rune(contentWithoutMinute)
-	symbol := string(runes[0])
-
+func renderStyledLiveUpdate(entry api.TimelineEntry, contentWidth int, details *api.MatchDetails, goalLinks GoalLinksMap) string {
 	// Use consolidated neon colors from neon_styles.go
 	whiteStyle := lipgloss.NewStyle().Foreground(neonWhite)
+	dimStyle := lipgloss.NewStyle().Foreground(neonDim)
 
-	var styledContent string
-	switch symbol {
-	case "●": // Goal - gradient on [GOAL] label, white text for player
-		startColor, _ := colorful.Hex(constants.GradientStartColor)
-		endColor, _ := colorful.Hex(constants.GradientEndColor)
-		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "[GOAL]")
-		styledType := applyGradientToText("GOAL", startColor, endColor)
-		styledPlayer := whiteStyle.Render(playerDetails)
-
-		// Check for replay link for live goals
-		replayIndicator := ""
-		if details != nil && goalLinks != nil {
-			// Extract minute from the update to look up replay URL
-			minuteStr := strings.TrimSuffix(minute, "'")
-			if minuteInt, err := strconv.Atoi(minuteStr); err == nil {
-				replayIndicator = getReplayIndicator(details, goalLinks, minuteInt)
-			}
-		}
-
-		styledContent = buildEventContent(styledPlayer, replayIndicator, symbol, styledType, isHome)
-	case "▪": // Yellow card
-		cardStyle := lipgloss.NewStyle().Foreground(neonYellow).Bold(true)
-		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "[CARD]")
-		styledContent = buildEventContent(whiteStyle.Render(playerDetails), "", symbol, cardStyle.Render("CARD"), isHome)
-	case "■": // Red card
-		cardStyle := lipgloss.NewStyle().Foreground(neonRed).Bold(true)
-		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "[CARD]")
-		styledContent = buildEventContent(whiteStyle.Render(playerDetails), "", symbol, cardStyle.Render("CARD"), isHome)
-	case "↔": // Substitution - color coded players
-		styledContent = renderSubstitutionWithColorsNoMinute(contentWithoutMinute, isHome)
-	case "·": // Other - dim symbol and text
-		dimStyle := lipgloss.NewStyle().Foreground(neonDim)
-		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "")
-		styledContent = buildEventContent(dimStyle.Render(playerDetails), "", symbol, "", isHome)
-	default:
-		// Unknown prefix, render as-is with default style
-		styledContent = whiteStyle.Render(contentWithoutMinute)
+	player := entry.Player
+	if player == "" {
+		player = "Unknown"
 	}
+	symbol, label := timelineMarker(entry.Kind)
 
-	// Apply center-aligned timeline
-	return renderCenterAlignedEvent(minute, styledContent, isHome, contentWidth)
-}
-
-// extractPlayerAndType extracts player details and type label from event content.
-// Input format: "● [GOAL] Player (assist)" or "▪ [CARD] Player"
-// Returns: playerDetails ("Player (assist)"), found type
-func extractPlayerAndType(content string, typeMarker string) (string, string) {
-	if typeMarker == "" {
-		// No type marker, just extract player after symbol
-		runes := []rune(content)
-		if len(runes) > 1 {
-			return strings.TrimSpace(string(runes[1:])), ""
+	var styledContent string
+	switch {
+	case entry.Disallowed:
+		// Goal ruled out after it was shown: struck through and dimmed, no replay link
+		struck := dimStyle.Strikethrough(true)
+		styledContent = buildEventContent(struck.Render(player), "", dimStyle.Render(TimelineSymbolGoal),
+			struck.Render(entry.Kind.Label())+" "+lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render("DISALLOWED"), entry.Home)
+	case entry.Kind.IsGoal():
+		// Check for replay link for live goals
+		replayIndicator := getReplayIndicator(details, goalLinks, entry.Minute)
+		styledContent = buildEventContent(whiteStyle.Render(player), replayIndicator, symbol, label, entry.Home)
+	case entry.Kind.IsCard(), entry.Kind == api.EventMissedPenalty:
+		styledContent = buildEventContent(whiteStyle.Render(player), "", symbol, label, entry.Home)
+	case entry.Kind == api.EventSubstitution:
+		styledContent = renderSubstitution(entry)
+	case entry.Kind == api.EventVAR:
+		decision := entry.Detail
+		if decision == "" {
+			decision = "Review"
 		}
-		return "", ""
-	}
-
-	_, after, ok := strings.Cut(content, typeMarker)
-	if !ok {
-		// Type marker not found, return content after symbol
-		runes := []rune(content)
-		if len(runes) > 1 {
-			return strings.TrimSpace(string(runes[1:])), ""
+		styledContent = buildEventContent(whiteStyle.Render(decision), "", symbol, label, entry.Home)
+	default: // Other - dim symbol and text
+		text := entry.Detail
+		if entry.Player != "" {
+			text = entry.Player
 		}
-		return "", ""
+		styledContent = buildEventContent(dimStyle.Render(text), "", symbol, label, entry.Home)
 	}
 
-	// Extract player details after the type marker
-	afterType := after
-	return strings.TrimSpace(afterType), typeMarker
+	// Apply center-aligned timeline
+	return renderCenterAlignedEvent(entry.MinuteLabel(), styledContent, entry.Home, contentWidth)
 }
 
-// renderSubstitutionWithColors renders a substitution event with color-coded players.
-// Cyan ← arrow = player coming IN (entering the pitch)
-// Red → arrow = player going OUT (leaving the pitch)
-// Format: ↔ 45' [SUB] {OUT}PlayerOut {IN}PlayerIn
-func renderSubstitutionWithColors(update string) string {
-	// Use consolidated neon colors from neon_styles.go
+// timelineMarker returns the styled symbol and label for an event kind:
+// gradient label for goals, yellow/red for cards, dim for substitutions and missed penalties,
+// cyan for VAR. Other events get the plain dot and no label.
+func timelineMarker(kind api.EventKind) (symbol, label string) {
 	dimStyle := lipgloss.NewStyle().Foreground(neonDim)
-	whiteStyle := lipgloss.NewStyle().Foreground(neonWhite)
-	outStyle := lipgloss.NewStyle().Foreground(neonRed) // Red = going OUT
-	inStyle := lipgloss.NewStyle().Foreground(neonCyan) // Cyan = coming IN
-
-	// Find the markers
-	outIdx := strings.Index(update, "{OUT}")
-	inIdx := strings.Index(update, "{IN}")
-
-	if outIdx == -1 || inIdx == -1 {
-		// Fallback to dim rendering if markers not found
-		return dimStyle.Render(update)
-	}
-
-	// Split the string into parts (no team suffix anymore - alignment handles team identity)
-	prefix := update[:outIdx]             // "↔ 45' [SUB] "
-	playerOut := update[outIdx+5 : inIdx] // Player going OUT (after {OUT}, before {IN})
-	playerIn := update[inIdx+4:]          // Player coming IN (after {IN} to end)
-
-	// Render prefix (symbol, time, [SUB]) in dim
-	result := dimStyle.Render(prefix)
-
-	// Render player coming IN with cyan ← arrow (entering the pitch)
-	result += inStyle.Render("← " + strings.TrimSpace(playerIn))
-	result += whiteStyle.Render(" ")
 
-	// Render player going OUT with red → arrow (leaving the pitch)
-	result += outStyle.Render("→ " + strings.TrimSpace(playerOut))
-
-	return result
-}
-
-// renderCardWithColor renders a card event with color on symbol, time, and [CARD] label.
-// The rest of the text (player, team) is rendered in white.
-func renderCardWithColor(update string, color lipgloss.Color) string {
-	// Use consolidated neon colors from neon_styles.go
-	colorStyle := lipgloss.NewStyle().Foreground(color).Bold(true)
-	whiteStyle := lipgloss.NewStyle().Foreground(neonWhite)
-
-	// Find [CARD] in the string
-	cardEnd := strings.Index(update, "[CARD]")
-	if cardEnd == -1 {
-		// No [CARD] found, color entire line
-		return colorStyle.Render(update)
-	}
-	cardEnd += len("[CARD]")
-
-	// Split: colored prefix (symbol + time + [CARD]) and white suffix (player + team)
-	prefix := update[:cardEnd]
-	suffix := update[cardEnd:]
-
-	return colorStyle.Render(prefix) + whiteStyle.Render(suffix)
-}
-
-// renderGoalWithGradient renders a goal event with gradient on the [GOAL] label.
-// The gradient matches the spinner theme (cyan → red).
-func renderGoalWithGradient(update string) string {
-	// Parse gradient colors
-	startColor, _ := colorful.Hex(constants.GradientStartColor) // Cyan
-	endColor, _ := colorful.Hex(constants.GradientEndColor)     // Red
-
-	// Use consolidated neon colors from neon_styles.go
-	whiteStyle := lipgloss.NewStyle().Foreground(neonWhite)
-
-	// Find [GOAL] in the string and apply gradient to it
-	goalStart := strings.Index(update, "[GOAL]")
-	if goalStart == -1 {
-		// No [GOAL] found, just render with gradient on first part
-		return applyGradientToText(update, startColor, endColor)
+	switch {
+	case kind.IsGoal():
+		startColor, _ := colorful.Hex(constants.GradientStartColor)
+		endColor, _ := colorful.Hex(constants.GradientEndColor)
+		return TimelineSymbolGoal, applyGradientToText(kind.Label(), startColor, endColor)
+	case kind == api.EventYellowCard:
+		return TimelineSymbolYellowCard, lipgloss.NewStyle().Foreground(neonYellow).Bold(true).Render(kind.Label())
+	case kind.IsSendingOff():
+		return TimelineSymbolRedCard, lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render(kind.Label())
+	case kind == api.EventSubstitution:
+		return TimelineSymbolSubstitution, dimStyle.Render(kind.Label())
+	case kind == api.EventMissedPenalty:
+		return dimStyle.Render(TimelineSymbolMissedPenalty), dimStyle.Render(kind.Label())
+	case kind == api.EventVAR:
+		varStyle := lipgloss.NewStyle().Foreground(neonCyan).Bold(true)
+		return varStyle.Render(TimelineSymbolVAR), varStyle.Render(kind.Label())
+	default:
+		return TimelineSymbolOther, ""
 	}
-
-	goalEnd := goalStart + len("[GOAL]")
-
-	// Build: prefix + gradient[GOAL] + suffix
-	prefix := update[:goalStart]
-	goalText := update[goalStart:goalEnd]
-	suffix := update[goalEnd:]
-
-	// Apply gradient to [GOAL] text character by character
-	gradientGoal := applyGradientToText(goalText, startColor, endColor)
-
-	// Render prefix (● and time) with gradient too for cohesion
-	gradientPrefix := applyGradientToText(prefix, startColor, endColor)
-
-	return gradientPrefix + gradientGoal + whiteStyle.Render(suffix)
 }
 
 // applyGradientToText applies a cyan→red gradient to text, character by character.
@@ -724,31 +617,31 @@ func applyGradientToText(text string, startColor, endColor colorful.Color) strin
 	return result.String()
 }
 
-// renderSubstitutionWithColorsNoMinute renders a substitution without the minute.
-// Format: "↔ [SUB] {OUT}PlayerOut {IN}PlayerIn" - minute already extracted
+// renderSubstitution renders a substitution with color-coded players:
+// cyan ← for the player coming on, red → for the player going off.
 // Uses buildEventContent for symbol+type adjacent to center alignment.
-func renderSubstitutionWithColorsNoMinute(update string, isHome bool) string {
+func renderSubstitution(entry api.TimelineEntry) string {
 	// Use consolidated neon colors from neon_styles.go
 	dimStyle := lipgloss.NewStyle().Foreground(neonDim)
 	outStyle := lipgloss.NewStyle().Foreground(neonRed)
 	inStyle := lipgloss.NewStyle().Foreground(neonCyan)
 
-	outIdx := strings.Index(update, "{OUT}")
-	inIdx := strings.Index(update, "{IN}")
-
-	if outIdx == -1 || inIdx == -1 {
-		return dimStyle.Render(update)
+	playerOut, playerIn := entry.Player, entry.PlayerIn
+	if playerOut == "" {
+		playerOut = "Unknown"
+	}
+	if playerIn == "" {
+		playerIn = "Unknown"
 	}
-
-	playerOut := strings.TrimSpace(update[outIdx+5 : inIdx])
-	playerIn := strings.TrimSpace(update[inIdx+4:])
 
 	// Format player details: ←PlayerIn →PlayerOut
 	playerDetails := inStyle.Render("←"+playerIn) + " " + outStyle.Render("→"+playerOut)
 
-	return buildEventContent(playerDetails, "", "↔", dimStyle.Render("SUB"), isHome)
+	return buildEventContent(playerDetails, "", TimelineSymbolSubstitution, dimStyle.Render(entry.Kind.Label()), entry.Home)
 }
 
+// formatMatchEventForDisplay formats a match event for display in the stats view
+// Uses neon styling with red/cyan theme and no emojis
 func formatMatchEventForDisplay(event api.MatchEvent, homeTeamID int, contentWidth int) string {
 	// Uses package-level neon colors from neon_styles.go
 	isHome := isHomeTeamEvent(event, homeTeamID)
@@ -758,50 +651,58 @@ func formatMatchEventForDisplay(event api.MatchEvent, homeTeamID int, contentWid
 	}
 	whiteStyle := lipgloss.NewStyle().Foreground(neonWhite)
 
+	playerName := "Unknown"
+	if event.Player != nil && *event.Player != "" {
+		playerName = *event.Player
+	}
+	dimStyle := lipgloss.NewStyle().Foreground(neonDim)
+
 	var eventContent string
-	switch event.Type {
-	case "goal":
-		playerName := "Unknown"
-		if event.Player != nil {
-			playerName = *event.Player
-		}
+	switch {
+	case event.Kind.IsGoal():
 		goalStyle := lipgloss.NewStyle().Foreground(neonRed).Bold(true)
 		playerDetails := whiteStyle.Render(playerName)
-		eventContent = buildEventContent(playerDetails, "", "●", goalStyle.Render("GOAL"), isHome)
-	case "card":
-		playerName := "Unknown"
-		if event.Player != nil {
-			playerName = *event.Player
-		}
-		cardType := "yellow"
-		if event.EventType != nil {
-			cardType = *event.EventType
-		}
+		eventContent = buildEventContent(playerDetails, "", TimelineSymbolGoal, goalStyle.Render(event.Kind.Label()), isHome)
+	case event.Kind == api.EventMissedPenalty:
+		playerDetails := whiteStyle.Render(playerName)
+		eventContent = buildEventContent(playerDetails, "", dimStyle.Render(TimelineSymbolMissedPenalty), dimStyle.Render(event.Kind.Label()), isHome)
+	case event.Kind.IsCard():
 		cardSymbol := CardSymbolYellow
 		cardStyle := neonYellowCardStyle
-		if cardType == "red" {
+		if event.Kind.IsSendingOff() {
 			cardSymbol = CardSymbolRed
 			cardStyle = neonRedCardStyle
 		}
 		playerDetails := whiteStyle.Render(playerName)
-		eventContent = buildEventContent(playerDetails, "", cardSymbol, cardStyle.Render("CARD"), isHome)
-	case "substitution":
-		playerName := "Unknown"
-		if event.Player != nil {
-			playerName = *event.Player
-		}
-		subStyle := lipgloss.NewStyle().Foreground(neonDim)
+		eventContent = buildEventContent(playerDetails, "", cardSymbol, cardStyle.Render(event.Kind.Label()), isHome)
+	case event.Kind == api.EventSubstitution:
 		playerDetails := whiteStyle.Render(playerName)
-		eventContent = buildEventContent(playerDetails, "", "↔", subStyle.Render("SUB"), isHome)
-	default:
-		playerName := ""
-		if event.Player != nil {
-			playerName = *event.Player
+		if event.PlayerIn != nil && *event.PlayerIn != "" {
+			playerIn := lipgloss.NewStyle().Foreground(neonCyan).Render("←" + *event.PlayerIn)
+			if event.Player == nil {
+				playerDetails = playerIn
+			} else {
+				playerDetails = playerIn + " " + lipgloss.NewStyle().Foreground(neonRed).Render("→"+playerName)
+			}
 		}
-		if playerName != "" {
-			eventContent = whiteStyle.Render(playerName)
-		} else {
-			eventContent = lipgloss.NewStyle().Foreground(neonDim).Render("Event")
+		eventContent = buildEventContent(playerDetails, "", TimelineSymbolSubstitution, dimStyle.Render("SUB"), isHome)
+	case event.Kind == api.EventVAR:
+		varStyle := lipgloss.NewStyle().Foreground(neonCyan).Bold(true)
+		decision := event.Detail
+		if decision == "" {
+			decision = "Review"
+		}
+		eventContent = buildEventContent(whiteStyle.Render(decision), "", varStyle.Render(TimelineSymbolVAR), varStyle.Render("VAR"), isHome)
+	case event.Kind == api.EventAddedTime && event.Detail != "":
+		eventContent = dimStyle.Render("+" + strings.TrimPrefix(event.Detail, "+") + " min added")
+	default:
+		switch {
+		case event.Player != nil && *event.Player != "":
+			eventContent = whiteStyle.Render(*event.Player)
+		case event.Detail != "":
+			eventContent = dimStyle.Render(event.Detail)
+		default:
+			eventContent = dimStyle.Render("Event")
 		}
 	}
 
@@ -0,0 +1,251 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// sparkBlocks are the block characters used for rating sparklines (lowest to highest).
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// Rating range mapped onto the sparkline - FotMob ratings rarely fall outside it.
+const (
+	sparkMinRating = 5.0
+	sparkMaxRating = 9.0
+)
+
+// PlayerCandidate is a player offered in the player picker.
+type PlayerCandidate struct {
+	ID     int
+	Name   string
+	Team   string // Team short name
+	Detail string // Why the player is listed (e.g., "67' goal", "Lineup")
+}
+
+// RenderPlayerView renders either the player picker or the selected player's profile.
+func RenderPlayerView(width, height int, candidates []PlayerCandidate, selected int, picking bool, player *api.Player, randomSpinner *RandomCharSpinner, loading bool, bannerType constants.StatusBannerType) string {
+	// Handle edge case: if width/height not set, use defaults
+	if width <= 0 {
+		width = 80
+	}
+	if height <= 0 {
+		height = 24
+	}
+
+	// Reserve 3 lines at top for spinner (always reserve to prevent layout shift)
+	spinnerHeight := 3
+	availableHeight := max(height-spinnerHeight, minPanelHeight)
+
+	spinnerStyle := lipgloss.NewStyle().
+		Width(width).
+		Height(spinnerHeight).
+		Align(lipgloss.Center).
+		AlignVertical(lipgloss.Center)
+
+	var spinnerArea string
+	if loading && randomSpinner != nil {
+		spinnerArea = spinnerStyle.Render(randomSpinner.View())
+	} else {
+		spinnerArea = spinnerStyle.Render("")
+	}
+
+	// Leave a line for the help text
+	panelHeight := availableHeight - 3
+
+	var body, helpText string
+	switch {
+	case picking:
+		body = renderPlayerPicker(width, panelHeight, candidates, selected)
+		helpText = constants.HelpPlayerPicker
+	case player == nil:
+		message := constants.LoadingFetching
+		if !loading {
+			message = constants.EmptyPlayerUnavailable
+		}
+		body = neonPanelStyle.Width(width).Height(panelHeight).Render(
+			neonEmptyStyle.Width(width - 6).Render(message),
+		)
+		helpText = constants.HelpPlayerView
+	default:
+		body = renderPlayerProfile(width, panelHeight, player)
+		helpText = constants.HelpPlayerView
+	}
+
+	help := lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Foreground(neonDim).
+		Render(helpText)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		spinnerArea,
+		renderStatusBanner(bannerType, width),
+		body,
+		help,
+	)
+}
+
+// renderPlayerPicker renders the list of candidate players with the selection highlighted.
+// The list scrolls to keep the selection visible.
+func renderPlayerPicker(width, height int, candidates []PlayerCandidate, selected int) string {
+	contentWidth := width - 6
+
+	lines := []string{neonPanelTitleStyle.Width(contentWidth).Render(constants.PanelSelectPlayer)}
+
+	visible := max(height-4, 1)
+	start := 0
+	if selected >= visible {
+		start = selected - visible + 1
+	}
+
+	for i := start; i < len(candidates) && i < start+visible; i++ {
+		c := candidates[i]
+		marker := "  "
+		nameStyle := neonValueStyle
+		if i == selected {
+			marker = lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render("▌ ")
+			nameStyle = neonTeamStyle
+		}
+		line := marker + nameStyle.Render(c.Name) + neonDimStyle.Render("  "+c.Team+"  "+c.Detail)
+		lines = append(lines, lipgloss.NewStyle().Width(contentWidth).MaxHeight(1).Render(line))
+	}
+
+	return neonPanelStyle.Width(width).Height(height).Render(strings.Join(lines, "\n"))
+}
+
+// renderPlayerProfile renders bio, season stats and recent ratings on the left, career on the right.
+func renderPlayerProfile(width, height int, player *api.Player) string {
+	leftWidth := max(width*60/100, 40)
+	rightWidth := max(width-leftWidth-1, 25) // -1 for separator
+	contentWidth := leftWidth - 6
+
+	var left []string
+	left = append(left, neonPanelTitleStyle.Width(contentWidth).Render(player.Name))
+
+	var bio []string
+	if player.Position != "" {
+		bio = append(bio, player.Position)
+	}
+	if player.Team.Name != "" {
+		bio = append(bio, player.Team.Name)
+	}
+	if player.Nationality != "" {
+		bio = append(bio, player.Nationality)
+	}
+	if len(bio) > 0 {
+		left = append(left, neonValueStyle.Render(strings.Join(bio, " • ")))
+	}
+
+	var physical []string
+	if player.Number > 0 {
+		physical = append(physical, fmt.Sprintf("#%d", player.Number))
+	}
+	if player.Age > 0 {
+		physical = append(physical, fmt.Sprintf("%d years", player.Age))
+	}
+	if player.Height != "" {
+		physical = append(physical, player.Height)
+	}
+	if len(physical) > 0 {
+		left = append(left, neonDimStyle.Render(strings.Join(physical, " • ")))
+	}
+
+	// Season stats per competition
+	if len(player.SeasonStats) > 0 {
+		left = append(left, "")
+		title := "Season Stats"
+		if player.SeasonStats[0].Season != "" {
+			title += " " + player.SeasonStats[0].Season
+		}
+		left = append(left, neonHeaderStyle.Render(title))
+		left = append(left, neonDimStyle.Render(fmt.Sprintf("%-22s %4s %4s %4s %6s", "", "Apps", "G", "A", "Rating")))
+		for _, s := range player.SeasonStats {
+			rating := "-"
+			if s.Rating > 0 {
+				rating = fmt.Sprintf("%.2f", s.Rating)
+			}
+			left = append(left, neonValueStyle.Render(fmt.Sprintf("%-22s %4d %4d %4d %6s",
+				truncateString(s.LeagueName, 22), s.Appearances, s.Goals, s.Assists, rating)))
+		}
+	}
+
+	// Recent match ratings as a sparkline (oldest to newest, left to right)
+	if spark, avg := ratingSparkline(player.RecentMatches); spark != "" {
+		left = append(left, "")
+		left = append(left, neonHeaderStyle.Render("Recent Ratings"))
+		left = append(left, spark+neonDimStyle.Render(fmt.Sprintf("  avg %.2f", avg)))
+		if last := player.RecentMatches[0]; last.Opponent != "" {
+			left = append(left, neonDimStyle.Render(fmt.Sprintf("Last: %.1f vs %s", last.Rating, last.Opponent)))
+		}
+	}
+
+	leftPanel := neonPanelStyle.Width(leftWidth).Height(height).Render(
+		truncateToHeight(strings.Join(left, "\n"), height-2),
+	)
+
+	// Career history
+	var right []string
+	right = append(right, neonPanelTitleStyle.Width(rightWidth-4).Render(constants.PanelCareer))
+	for _, c := range player.Career {
+		years := fmt.Sprintf("%d-", c.StartYear)
+		if c.EndYear > 0 {
+			years = fmt.Sprintf("%d-%d", c.StartYear, c.EndYear)
+		}
+		if c.StartYear == 0 {
+			years = ""
+		}
+		nameStyle := neonValueStyle
+		if c.Active {
+			nameStyle = neonTeamStyle
+		}
+		line := neonDimStyle.Render(fmt.Sprintf("%-10s ", years)) + nameStyle.Render(c.TeamName)
+		if c.Appearances > 0 {
+			line += neonDimStyle.Render(fmt.Sprintf("  %d apps, %d goals", c.Appearances, c.Goals))
+		}
+		right = append(right, lipgloss.NewStyle().Width(rightWidth-4).MaxHeight(1).Render(line))
+	}
+
+	rightPanel := neonPanelCyanStyle.Width(rightWidth).Height(height).Render(
+		truncateToHeight(strings.Join(right, "\n"), height),
+	)
+
+	separator := neonSeparatorStyle.Height(height).Render("┃")
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, separator, rightPanel)
+}
+
+// ratingSparkline renders rated matches oldest-to-newest as a coloured sparkline.
+// Matches without a rating are skipped. Returns the average rating alongside.
+func ratingSparkline(matches []api.PlayerMatchRating) (string, float64) {
+	var b strings.Builder
+	total, count := 0.0, 0
+	for i := len(matches) - 1; i >= 0; i-- {
+		rating := matches[i].Rating
+		if rating <= 0 {
+			continue
+		}
+		total += rating
+		count++
+
+		level := int((rating - sparkMinRating) / (sparkMaxRating - sparkMinRating) * float64(len(sparkBlocks)-1))
+		level = max(0, min(level, len(sparkBlocks)-1))
+
+		style := neonValueStyle
+		switch {
+		case rating >= 8.0:
+			style = neonTeamStyle
+		case rating < 6.0:
+			style = neonScoreStyle
+		}
+		b.WriteString(style.Render(string(sparkBlocks[level])))
+	}
+	if count == 0 {
+		return "", 0
+	}
+	return b.String(), total / float64(count)
+}
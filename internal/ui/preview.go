@@ -0,0 +1,191 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// renderMatchPreviewSection renders the pre-match preview for a not-started match:
+// kickoff countdown, venue and referee, league positions, recent form, head-to-head,
+// and confirmed or predicted lineups with unavailable players.
+// table may be nil, in which case league positions are omitted.
+// Returns one string per line so callers can append to panel content.
+func renderMatchPreviewSection(details *api.MatchDetails, table []api.LeagueTableEntry, contentWidth int, now time.Time) []string {
+	if details == nil {
+		return nil
+	}
+
+	var lines []string
+
+	// Kickoff countdown, then venue and referee
+	center := lipgloss.NewStyle().Width(contentWidth).Align(lipgloss.Center)
+	if details.Status == api.MatchStatusPostponed {
+		lines = append(lines, center.Render(neonScoreStyle.Render(details.State.Label())))
+	} else if details.MatchTime != nil {
+		kickoff := neonDimStyle.Render("Kickoff " + details.MatchTime.Local().Format("15:04"))
+		if countdown := formatCountdown(details.MatchTime.Sub(now)); countdown != "" {
+			kickoff += neonDimStyle.Render(" • ") + neonScoreStyle.Render(countdown)
+		}
+		lines = append(lines, center.Render(kickoff))
+	}
+
+	var info []string
+	if details.Venue != "" {
+		info = append(info, details.Venue)
+	}
+	if details.Referee != "" {
+		info = append(info, "Ref: "+details.Referee)
+	}
+	if len(info) > 0 {
+		lines = append(lines, center.Render(neonDimStyle.Render(strings.Join(info, " | "))))
+	}
+
+	// League positions
+	if home, away := findTableEntry(table, details.HomeTeam.ID), findTableEntry(table, details.AwayTeam.ID); home != nil || away != nil {
+		lines = append(lines, "")
+		lines = append(lines, neonHeaderStyle.Render("League Position"))
+		lines = append(lines, renderTwoColumns(formatTablePosition(home), formatTablePosition(away), contentWidth))
+	}
+
+	// Last five results for each side
+	if len(details.HomeForm) > 0 || len(details.AwayForm) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, neonHeaderStyle.Render("Form"))
+		lines = append(lines, renderTwoColumns(formatForm(details.HomeForm), formatForm(details.AwayForm), contentWidth))
+	}
+
+	lines = append(lines, renderHeadToHeadSection(details, contentWidth)...)
+	lines = append(lines, renderLineupSection(details, contentWidth)...)
+
+	return lines
+}
+
+// renderHeadToHeadSection renders the head-to-head summary and most recent meetings.
+// Returns nil when no head-to-head data is available.
+func renderHeadToHeadSection(details *api.MatchDetails, contentWidth int) []string {
+	h2h := details.HeadToHead
+	if h2h == nil {
+		return nil
+	}
+
+	var lines []string
+	lines = append(lines, "")
+	lines = append(lines, neonHeaderStyle.Render("Head to Head"))
+
+	summary := fmt.Sprintf("%s %s  %s %s  %s %s",
+		neonValueStyle.Render(fmt.Sprintf("%d", h2h.HomeWins)), neonDimStyle.Render(details.HomeTeam.ShortName),
+		neonValueStyle.Render(fmt.Sprintf("%d", h2h.Draws)), neonDimStyle.Render("draws"),
+		neonValueStyle.Render(fmt.Sprintf("%d", h2h.AwayWins)), neonDimStyle.Render(details.AwayTeam.ShortName),
+	)
+	lines = append(lines, lipgloss.NewStyle().Width(contentWidth).Align(lipgloss.Center).Render(summary))
+
+	for _, match := range h2h.Matches {
+		lines = append(lines, formatHeadToHeadMatch(match, contentWidth))
+	}
+
+	return lines
+}
+
+// formatHeadToHeadMatch formats a previous meeting as "12 Jan 25  Home 2 - 1 Away".
+func formatHeadToHeadMatch(match api.Match, contentWidth int) string {
+	dateStr := "         "
+	if match.MatchTime != nil {
+		dateStr = match.MatchTime.Local().Format("02 Jan 06")
+	}
+
+	score := "-"
+	if match.HomeScore != nil && match.AwayScore != nil {
+		score = fmt.Sprintf("%d - %d", *match.HomeScore, *match.AwayScore)
+	}
+
+	maxTeamLen := max((contentWidth-len(dateStr)-len(score)-6)/2, 3)
+	line := fmt.Sprintf("%s  %s %s %s",
+		neonDimStyle.Render(dateStr),
+		neonValueStyle.Render(truncateString(match.HomeTeam.Name, maxTeamLen)),
+		neonScoreStyle.Render(score),
+		neonValueStyle.Render(truncateString(match.AwayTeam.Name, maxTeamLen)),
+	)
+	return lipgloss.NewStyle().Width(contentWidth).MaxHeight(1).Render(line)
+}
+
+// formatForm renders recent results as coloured letters, most recent first (e.g., "W W D L W").
+func formatForm(form []api.FormResult) string {
+	if len(form) == 0 {
+		return neonDimStyle.Render("-")
+	}
+
+	parts := make([]string, 0, min(len(form), 5))
+	for i, r := range form {
+		if i >= 5 {
+			break
+		}
+		style := neonDimStyle
+		switch r.Result {
+		case "W":
+			style = neonTeamStyle
+		case "L":
+			style = neonScoreStyle
+		}
+		parts = append(parts, style.Render(r.Result))
+	}
+	return strings.Join(parts, " ")
+}
+
+// findTableEntry returns the table entry for the given team ID, or nil if not found.
+func findTableEntry(table []api.LeagueTableEntry, teamID int) *api.LeagueTableEntry {
+	for i := range table {
+		if table[i].Team.ID == teamID {
+			return &table[i]
+		}
+	}
+	return nil
+}
+
+// formatTablePosition formats a table entry as "3rd • 45 pts".
+func formatTablePosition(entry *api.LeagueTableEntry) string {
+	if entry == nil {
+		return neonDimStyle.Render("-")
+	}
+	return neonValueStyle.Render(ordinal(entry.Position)) + neonDimStyle.Render(fmt.Sprintf(" • %d pts", entry.Points))
+}
+
+// formatCountdown formats the time until kickoff (e.g., "2h 15m", "45m").
+// Returns an empty string once kickoff time has passed.
+func formatCountdown(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	d = d.Round(time.Minute)
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	switch {
+	case hours >= 24:
+		return fmt.Sprintf("%dd %dh", hours/24, hours%24)
+	case hours > 0:
+		return fmt.Sprintf("%dh %02dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", max(minutes, 1))
+	}
+}
+
+// ordinal returns the English ordinal for n (1st, 2nd, 3rd, 4th, 11th, ...).
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 100 {
+	case 11, 12, 13:
+	default:
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
@@ -2,10 +2,15 @@ package ui
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/notify"
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -19,10 +24,29 @@ type SettingsState struct {
 	Leagues       []data.LeagueInfo // All leagues for current region
 	AllLeagues    []data.LeagueInfo // All leagues across all regions
 	Regions       []string          // Available regions
-	CurrentRegion int               // Index of current region
+	CurrentRegion int               // Index of current tab: a region, then Teams, then Notifications
 	HasChanges    bool              // Whether there are unsaved changes
+
+	// Teams tab
+	Followed    []data.FollowedTeam    // Followed teams, in the order they were added
+	TeamResults []api.TeamSearchResult // Results of the last team search
+	Search      textinput.Model        // Team search input
+	Searching   bool                   // Whether a team search is in flight
+	SearchedFor string                 // Query of the last completed search
+
+	// Notifications tab
+	Rules       []data.NotificationRule // Notification rules; the defaults until edited if none are saved
+	RulesEdited bool                    // Whether the rules were edited, so the defaults aren't saved as-is
+
+	settings *data.Settings // Loaded settings, so saving keeps what this view doesn't edit
 }
 
+// Labels of the tabs after the regions.
+const (
+	teamsTabName = "Teams"
+	rulesTabName = "Alerts"
+)
+
 // NewSettingsState creates a new settings state with current saved preferences.
 func NewSettingsState() *SettingsState {
 	settings, _ := data.LoadSettings()
@@ -58,6 +82,15 @@ func NewSettingsState() *SettingsState {
 		}
 	}
 
+	// Team search input, focused with '/' on the Teams tab
+	search := textinput.New()
+	search.Placeholder = "Search teams"
+	search.CharLimit = 40
+	filterCursorStyle, filterPromptStyle := FilterInputStyles()
+	search.Prompt = "Search: "
+	search.PromptStyle = filterPromptStyle
+	search.Cursor.Style = filterCursorStyle
+
 	// Create and configure the list
 	delegate := NewLeagueListDelegate()
 	l := list.New(items, delegate, 0, 0)
@@ -69,7 +102,6 @@ func NewSettingsState() *SettingsState {
 	l.SetShowHelp(false) // We use our own help text
 
 	// Apply filter input styles
-	filterCursorStyle, filterPromptStyle := FilterInputStyles()
 	l.Styles.FilterCursor = filterCursorStyle
 	l.FilterInput.PromptStyle = filterPromptStyle
 	l.FilterInput.Cursor.Style = filterCursorStyle
@@ -81,20 +113,78 @@ func NewSettingsState() *SettingsState {
 		AllLeagues:    allLeagueInfos,
 		Regions:       regions,
 		CurrentRegion: currentRegion,
+		Followed:      settings.FollowedTeams,
+		Search:        search,
+		Rules:         settings.NotificationRules,
+		settings:      settings,
 	}
 }
 
-// Toggle toggles the selection state of the currently highlighted league.
+// Toggle toggles the selection state of the currently highlighted league,
+// or follows/unfollows the highlighted team on the Teams tab.
 func (s *SettingsState) Toggle() {
-	if item, ok := s.List.SelectedItem().(LeagueListItem); ok {
+	switch item := s.List.SelectedItem().(type) {
+	case LeagueListItem:
 		s.Selected[item.League.ID] = !s.Selected[item.League.ID]
-		s.HasChanges = true
-		s.refreshListItems()
+	case TeamListItem:
+		s.toggleTeam(item.Team)
+	case RuleListItem:
+		s.editRule(func(rule *data.NotificationRule) { rule.Disabled = !rule.Disabled })
+		return
+	default:
+		return
+	}
+	s.HasChanges = true
+	s.refreshListItems()
+}
+
+// toggleTeam follows the team, or unfollows it if already followed.
+func (s *SettingsState) toggleTeam(team data.FollowedTeam) {
+	for i, followed := range s.Followed {
+		if followed.ID == team.ID {
+			s.Followed = append(s.Followed[:i:i], s.Followed[i+1:]...)
+			return
+		}
+	}
+	s.Followed = append(s.Followed, team)
+}
+
+// isFollowed reports whether the team is in the followed list.
+func (s *SettingsState) isFollowed(teamID int) bool {
+	for _, team := range s.Followed {
+		if team.ID == teamID {
+			return true
+		}
 	}
+	return false
+}
+
+// OnTeamsTab reports whether the Teams tab is shown.
+func (s *SettingsState) OnTeamsTab() bool {
+	return s.CurrentRegion == len(s.Regions)
 }
 
-// refreshListItems updates the list items to reflect current selection state for the current region.
+// OnRulesTab reports whether the Notifications tab is shown.
+func (s *SettingsState) OnRulesTab() bool {
+	return s.CurrentRegion == len(s.Regions)+1
+}
+
+// tabCount returns the number of tabs: the regions, Teams and Notifications.
+func (s *SettingsState) tabCount() int {
+	return len(s.Regions) + 2
+}
+
+// refreshListItems updates the list items to reflect current selection state for the current tab.
 func (s *SettingsState) refreshListItems() {
+	if s.OnTeamsTab() {
+		s.List.SetItems(s.teamItems())
+		return
+	}
+	if s.OnRulesTab() {
+		s.List.SetItems(s.ruleItems())
+		return
+	}
+
 	items := make([]list.Item, len(s.Leagues))
 	for i, league := range s.Leagues {
 		items[i] = LeagueListItem{
@@ -105,36 +195,264 @@ func (s *SettingsState) refreshListItems() {
 	s.List.SetItems(items)
 }
 
-// switchToRegion switches to a different region and updates the league list.
+// teamItems lists the followed teams missing from the search results, then the results.
+func (s *SettingsState) teamItems() []list.Item {
+	inResults := make(map[int]bool, len(s.TeamResults))
+	for _, result := range s.TeamResults {
+		inResults[result.Team.ID] = true
+	}
+
+	items := make([]list.Item, 0, len(s.Followed)+len(s.TeamResults))
+	for _, team := range s.Followed {
+		if !inResults[team.ID] {
+			items = append(items, TeamListItem{Team: team, Selected: true})
+		}
+	}
+	for _, result := range s.TeamResults {
+		team := data.FollowedTeam{ID: result.Team.ID, Name: result.Team.Name, LeagueID: result.LeagueID, League: result.League}
+		items = append(items, TeamListItem{Team: team, Selected: s.isFollowed(team.ID)})
+	}
+	return items
+}
+
+// ruleItems lists the notification rules, showing the defaults while none are saved.
+func (s *SettingsState) ruleItems() []list.Item {
+	if !s.RulesEdited && len(s.settings.NotificationRules) == 0 {
+		// The defaults depend on whether teams are followed
+		s.Rules = notify.DefaultRules(len(s.Followed) > 0)
+	}
+
+	items := make([]list.Item, len(s.Rules))
+	for i, rule := range s.Rules {
+		items[i] = RuleListItem{Rule: rule, Scope: s.ruleScope(rule)}
+	}
+	return items
+}
+
+// ruleScope describes the matches a rule covers, naming teams and leagues where known.
+func (s *SettingsState) ruleScope(rule data.NotificationRule) string {
+	var names []string
+	if rule.Followed {
+		names = append(names, "Followed teams")
+	}
+	for _, id := range rule.Teams {
+		name := fmt.Sprintf("Team %d", id)
+		for _, team := range s.Followed {
+			if team.ID == id {
+				name = team.Name
+			}
+		}
+		names = append(names, name)
+	}
+
+	var leagues []string
+	for _, id := range rule.Leagues {
+		name := fmt.Sprintf("League %d", id)
+		for _, league := range s.AllLeagues {
+			if league.ID == id {
+				name = league.Name
+			}
+		}
+		leagues = append(leagues, name)
+	}
+
+	switch {
+	case len(names) == 0 && len(leagues) == 0:
+		return "All matches"
+	case len(leagues) == 0:
+		return strings.Join(names, ", ")
+	case len(names) == 0:
+		return strings.Join(leagues, ", ")
+	default:
+		return strings.Join(names, ", ") + " in " + strings.Join(leagues, ", ")
+	}
+}
+
+// editRule applies the edit to the highlighted rule.
+func (s *SettingsState) editRule(edit func(rule *data.NotificationRule)) {
+	i := s.List.Index()
+	if !s.OnRulesTab() || i < 0 || i >= len(s.Rules) {
+		return
+	}
+	s.Rules = slices.Clone(s.Rules)
+	rule := s.Rules[i]
+	rule.Events = slices.Clone(rule.Events)
+	rule.When = slices.Clone(rule.When)
+	edit(&rule)
+	s.Rules[i] = rule
+
+	s.RulesEdited = true
+	s.HasChanges = true
+	s.refreshListItems()
+}
+
+// ToggleRuleEvent turns an event kind (an index into notify.Kinds) on or off for the highlighted rule.
+// A rule keeps at least one event; all of them are stored as none, which matches every kind.
+func (s *SettingsState) ToggleRuleEvent(index int) {
+	if index < 0 || index >= len(notify.Kinds) {
+		return
+	}
+	kind := string(notify.Kinds[index])
+
+	s.editRule(func(rule *data.NotificationRule) {
+		events := rule.Events
+		if len(events) == 0 {
+			for _, k := range notify.Kinds {
+				events = append(events, string(k))
+			}
+		}
+
+		if i := slices.Index(events, kind); i >= 0 {
+			if len(events) == 1 {
+				return
+			}
+			events = slices.Delete(events, i, i+1)
+		} else {
+			// Keep the settings order
+			events = nil
+			for _, k := range notify.Kinds {
+				if string(k) == kind || slices.Contains(rule.Events, string(k)) {
+					events = append(events, string(k))
+				}
+			}
+		}
+
+		if len(events) == len(notify.Kinds) {
+			events = nil
+		}
+		rule.Events = events
+	})
+}
+
+// CycleRuleCondition moves the highlighted rule to the next match state condition:
+// none, then each of notify.Conditions in turn. Combined conditions go back to none.
+func (s *SettingsState) CycleRuleCondition() {
+	s.editRule(func(rule *data.NotificationRule) {
+		next := 0
+		if len(rule.When) > 0 {
+			next = len(notify.Conditions)
+			if i := slices.Index(notify.Conditions, rule.When[0]); len(rule.When) == 1 && i >= 0 {
+				next = i + 1
+			}
+		}
+
+		if next < len(notify.Conditions) {
+			rule.When = []string{notify.Conditions[next]}
+		} else {
+			rule.When = nil
+		}
+	})
+}
+
+// CycleRuleChannel moves the highlighted rule to the next notification channel:
+// the desktop, then each configured webhook and command hook. Multiple channels
+// (set in settings.yaml) go back to the desktop.
+func (s *SettingsState) CycleRuleChannel() {
+	channels := []string{notify.ChannelDesktop}
+	for _, webhook := range s.settings.Webhooks {
+		channels = append(channels, webhook.Name)
+	}
+	for _, hook := range s.settings.Hooks {
+		channels = append(channels, hook.Name)
+	}
+
+	s.editRule(func(rule *data.NotificationRule) {
+		next := 0
+		if len(rule.Channels) == 1 {
+			if i := slices.Index(channels, rule.Channels[0]); i >= 0 {
+				next = (i + 1) % len(channels)
+			}
+		}
+		rule.Channels = []string{channels[next]}
+	})
+}
+
+// AddRule adds a rule for goals on the desktop, for followed teams if any, and highlights it.
+func (s *SettingsState) AddRule() {
+	if !s.OnRulesTab() {
+		return
+	}
+	s.Rules = append(slices.Clone(s.Rules), data.NotificationRule{
+		Name:     fmt.Sprintf("Rule %d", len(s.Rules)+1),
+		Followed: len(s.Followed) > 0,
+		Events:   []string{string(notify.KindGoal)},
+		Channels: []string{notify.ChannelDesktop},
+	})
+	s.RulesEdited = true
+	s.HasChanges = true
+	s.refreshListItems()
+	s.List.Select(len(s.Rules) - 1)
+}
+
+// DeleteRule removes the highlighted rule.
+// With no rules left, the defaults apply again.
+func (s *SettingsState) DeleteRule() {
+	i := s.List.Index()
+	if !s.OnRulesTab() || i < 0 || i >= len(s.Rules) {
+		return
+	}
+	s.Rules = slices.Delete(slices.Clone(s.Rules), i, i+1)
+	s.RulesEdited = true
+	s.HasChanges = true
+	s.refreshListItems()
+}
+
+// switchToRegion switches to a different tab (a region, Teams or Notifications) and updates the list.
 func (s *SettingsState) switchToRegion(regionIndex int) {
-	if regionIndex < 0 || regionIndex >= len(s.Regions) {
+	if regionIndex < 0 || regionIndex >= s.tabCount() {
 		return
 	}
 
 	s.CurrentRegion = regionIndex
-	s.Leagues = data.GetLeaguesForRegion(s.Regions[regionIndex])
+	if regionIndex >= len(s.Regions) {
+		s.Leagues = nil
+	} else {
+		s.Leagues = data.GetLeaguesForRegion(s.Regions[regionIndex])
+	}
 	s.refreshListItems()
 
-	// Reset filter when switching regions
+	// Reset filter when switching tabs; only the regions filter
+	// (the Teams tab searches with its own input)
 	s.List.ResetFilter()
+	s.List.SetFilteringEnabled(regionIndex < len(s.Regions))
+	s.Search.Blur()
 }
 
-// NextRegion switches to the next region (with wraparound).
+// NextRegion switches to the next tab (with wraparound).
 func (s *SettingsState) NextRegion() {
-	nextRegion := (s.CurrentRegion + 1) % len(s.Regions)
+	nextRegion := (s.CurrentRegion + 1) % s.tabCount()
 	s.switchToRegion(nextRegion)
 }
 
-// PreviousRegion switches to the previous region (with wraparound).
+// PreviousRegion switches to the previous tab (with wraparound).
 func (s *SettingsState) PreviousRegion() {
 	prevRegion := s.CurrentRegion - 1
 	if prevRegion < 0 {
-		prevRegion = len(s.Regions) - 1
+		prevRegion = s.tabCount() - 1
 	}
 	s.switchToRegion(prevRegion)
 }
 
-// Save persists the current selection to settings.yaml.
+// FocusSearch focuses the team search input.
+func (s *SettingsState) FocusSearch() {
+	s.Search.Focus()
+}
+
+// SetTeamResults shows the results of a team search, unless a newer search was started.
+func (s *SettingsState) SetTeamResults(query string, results []api.TeamSearchResult) {
+	if query != s.Search.Value() {
+		return
+	}
+	s.Searching = false
+	s.SearchedFor = query
+	s.TeamResults = results
+	if s.OnTeamsTab() {
+		s.refreshListItems()
+		s.List.Select(0)
+	}
+}
+
+// Save persists the current selection, followed teams and edited rules to settings.yaml.
 func (s *SettingsState) Save() error {
 	var selectedIDs []int
 	for _, league := range s.AllLeagues {
@@ -143,11 +461,14 @@ func (s *SettingsState) Save() error {
 		}
 	}
 
-	settings := &data.Settings{
-		SelectedLeagues: selectedIDs,
+	settings := *s.settings
+	settings.SelectedLeagues = selectedIDs
+	settings.FollowedTeams = s.Followed
+	if s.RulesEdited {
+		settings.NotificationRules = s.Rules
 	}
 
-	err := data.SaveSettings(settings)
+	err := data.SaveSettings(&settings)
 	if err == nil {
 		s.HasChanges = false
 	}
@@ -166,13 +487,13 @@ func (s *SettingsState) SelectedCount() int {
 }
 
 // Fixed width for settings panel
-const settingsBoxWidth = 48
+const settingsBoxWidth = 54
 
-// renderTabBar renders the regional tabs at the top of the settings view.
+// renderTabBar renders the regional tabs, then the Teams and Notifications tabs, at the top of the settings view.
 func renderTabBar(regions []string, currentRegion int, width int) string {
 	var tabElements []string
 
-	for i, region := range regions {
+	for i, region := range append(regions[:len(regions):len(regions)], teamsTabName, rulesTabName) {
 		var tabStyle lipgloss.Style
 
 		if i == currentRegion {
@@ -198,6 +519,55 @@ func renderTabBar(regions []string, currentRegion int, width int) string {
 	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(tabs)
 }
 
+// teamsInfo summarizes the Teams tab: search progress, then the followed count.
+func teamsInfo(state *SettingsState) string {
+	switch {
+	case state.Searching:
+		return "Searching..."
+	case state.SearchedFor != "" && len(state.TeamResults) == 0:
+		return fmt.Sprintf("No teams found for %q", state.SearchedFor)
+	case len(state.Followed) == 0:
+		return "No teams followed - notifications cover all matches"
+	case len(state.Followed) == 1:
+		return "1 team followed"
+	default:
+		return fmt.Sprintf("%d teams followed", len(state.Followed))
+	}
+}
+
+// renderRuleEventKeys renders the keys toggling each event kind on the Notifications tab.
+func renderRuleEventKeys() string {
+	// Four per line, so no label is split; the tenth kind is on 0
+	var lines []string
+	var keys []string
+	for i, kind := range notify.Kinds {
+		keys = append(keys, fmt.Sprintf("%d %s", (i+1)%10, kind.Label()))
+		if len(keys) == 4 || i == len(notify.Kinds)-1 {
+			lines = append(lines, strings.Join(keys, "  "))
+			keys = nil
+		}
+	}
+	return neonDimStyle.Width(settingsBoxWidth).Align(lipgloss.Center).Render(strings.Join(lines, "\n"))
+}
+
+// rulesInfo summarizes the Notifications tab.
+func rulesInfo(state *SettingsState) string {
+	enabled := 0
+	for _, rule := range state.Rules {
+		if !rule.Disabled {
+			enabled++
+		}
+	}
+	switch {
+	case !state.RulesEdited && len(state.settings.NotificationRules) == 0:
+		return "Default rule - edit to customize"
+	case len(state.Rules) == 0:
+		return "No rules - the default applies"
+	default:
+		return fmt.Sprintf("%d of %d rules on", enabled, len(state.Rules))
+	}
+}
+
 // RenderSettingsView renders the settings view for league customization.
 // Uses minimal styling consistent with the rest of the app (red/cyan neon theme).
 // bannerType determines what status banner (if any) to display at the top.
@@ -217,6 +587,12 @@ func RenderSettingsView(width, height int, state *SettingsState, bannerType cons
 
 	listWidth := settingsBoxWidth
 	listHeight := height - titleHeight - tabsHeight - infoHeight - helpHeight - extraPadding
+	switch {
+	case state.OnTeamsTab():
+		listHeight -= 2 // Search input
+	case state.OnRulesTab():
+		listHeight -= 4 // Event keys (three lines)
+	}
 	if listHeight < 5 {
 		listHeight = 5
 	}
@@ -231,23 +607,41 @@ func RenderSettingsView(width, height int, state *SettingsState, bannerType cons
 	}
 
 	// Title - red like other panel titles
+	titleText := "League Preferences"
+	switch {
+	case state.OnTeamsTab():
+		titleText = "Followed Teams"
+	case state.OnRulesTab():
+		titleText = "Notification Rules"
+	}
 	titleStyle := neonPanelTitleStyle.Width(settingsBoxWidth)
-	title := titleStyle.Render("League Preferences")
+	title := titleStyle.Render(titleText)
 
 	// Render the tab bar
 	tabs := renderTabBar(state.Regions, state.CurrentRegion, settingsBoxWidth)
 
-	// Render the list
+	// Render the list, under the search input on the Teams tab
 	listContent := state.List.View()
+	switch {
+	case state.OnTeamsTab():
+		listContent = state.Search.View() + "\n\n" + listContent
+	case state.OnRulesTab():
+		listContent = renderRuleEventKeys() + "\n\n" + listContent
+	}
 	listContainerStyle := lipgloss.NewStyle().Width(settingsBoxWidth)
 	listContent = listContainerStyle.Render(listContent)
 
 	// Selection info
 	selectedCount := state.SelectedCount()
 	var infoText string
-	if selectedCount == 0 {
+	switch {
+	case state.OnTeamsTab():
+		infoText = teamsInfo(state)
+	case state.OnRulesTab():
+		infoText = rulesInfo(state)
+	case selectedCount == 0:
 		infoText = "No selection = default leagues"
-	} else {
+	default:
 		infoText = fmt.Sprintf("%d of %d selected", selectedCount, len(state.AllLeagues))
 	}
 	infoStyle := neonDimStyle.Width(settingsBoxWidth).Align(lipgloss.Center)
@@ -255,6 +649,12 @@ func RenderSettingsView(width, height int, state *SettingsState, bannerType cons
 
 	// Help text - update to include tab navigation
 	helpText := constants.HelpSettingsView + "  ←/→: switch tabs"
+	switch {
+	case state.OnTeamsTab():
+		helpText = constants.HelpSettingsTeams + "  ←/→: switch tabs"
+	case state.OnRulesTab():
+		helpText = constants.HelpSettingsRules + "  ←/→: switch tabs"
+	}
 	helpStyle := neonDimStyle.Width(settingsBoxWidth).Align(lipgloss.Center)
 	help := helpStyle.Render(helpText)
 
@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Shootout kick symbols: ● scored, ○ saved, ✕ missed.
+const (
+	ShootoutSymbolScored = "●"
+	ShootoutSymbolSaved  = "○"
+	ShootoutSymbolMissed = "✕"
+)
+
+// renderScoreContext renders the line under the score for knockout matches:
+// the aggregate of a two-legged tie and the penalty shootout result.
+// Returns "" when neither applies.
+func renderScoreContext(details *api.MatchDetails, width int) string {
+	var parts []string
+	if details.HomeAggregate != nil && details.AwayAggregate != nil {
+		parts = append(parts, fmt.Sprintf("Agg %d - %d", *details.HomeAggregate, *details.AwayAggregate))
+	}
+	if details.Penalties != nil && details.Penalties.Home != nil && details.Penalties.Away != nil {
+		parts = append(parts, fmt.Sprintf("Pens %d - %d", *details.Penalties.Home, *details.Penalties.Away))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Render(neonDimStyle.Render(strings.Join(parts, "  •  ")))
+}
+
+// renderShootout renders a penalty shootout: a row of kick outcomes per team with the
+// shootout score, followed by each kick on the center-aligned timeline with its running score.
+// Returns nil when the match had no shootout.
+func renderShootout(details *api.MatchDetails, contentWidth int) []string {
+	if len(details.Shootout) == 0 {
+		return nil
+	}
+
+	homeName := details.HomeTeam.ShortName
+	if homeName == "" {
+		homeName = details.HomeTeam.Name
+	}
+	awayName := details.AwayTeam.ShortName
+	if awayName == "" {
+		awayName = details.AwayTeam.Name
+	}
+	nameWidth := min(max(lipgloss.Width(homeName), lipgloss.Width(awayName)), 16)
+
+	var homeKicks, awayKicks []string
+	for _, kick := range details.Shootout {
+		if kick.Team.ID == details.HomeTeam.ID {
+			homeKicks = append(homeKicks, shootoutSymbol(kick.Outcome))
+		} else {
+			awayKicks = append(awayKicks, shootoutSymbol(kick.Outcome))
+		}
+	}
+
+	last := details.Shootout[len(details.Shootout)-1]
+	homeScore, awayScore := last.HomeScore, last.AwayScore
+	if details.Penalties != nil && details.Penalties.Home != nil && details.Penalties.Away != nil {
+		homeScore, awayScore = *details.Penalties.Home, *details.Penalties.Away
+	}
+
+	// Pad the kick rows to the same width (kick symbols are styled, so pad by count)
+	kickColumns := max(len(homeKicks), len(awayKicks))
+	summaryRow := func(name string, kicks []string, score int) string {
+		return lipgloss.NewStyle().Width(contentWidth).Align(lipgloss.Center).Render(
+			neonTeamStyle.Render(fmt.Sprintf("%-*s", nameWidth, truncateString(name, nameWidth))) + "  " +
+				strings.Join(kicks, " ") + strings.Repeat("  ", kickColumns-len(kicks)) + " " +
+				neonScoreStyle.Render(fmt.Sprintf("%2d", score)))
+	}
+
+	lines := []string{
+		neonHeaderStyle.Render("Penalty Shootout"),
+		summaryRow(homeName, homeKicks, homeScore),
+		summaryRow(awayName, awayKicks, awayScore),
+		"",
+	}
+
+	for _, kick := range details.Shootout {
+		isHome := kick.Team.ID == details.HomeTeam.ID
+		player := kick.Player
+		if player == "" {
+			player = "Unknown"
+		}
+
+		label := neonScoreStyle.Render("SCORED")
+		switch kick.Outcome {
+		case api.PenaltySaved:
+			label = neonDimStyle.Render("SAVED")
+		case api.PenaltyMissed:
+			label = neonDimStyle.Render("MISSED")
+		}
+
+		content := buildEventContent(neonValueStyle.Render(player), "", shootoutSymbol(kick.Outcome), label, isHome)
+		lines = append(lines, renderCenterAlignedEvent(fmt.Sprintf("%d-%d", kick.HomeScore, kick.AwayScore), content, isHome, contentWidth))
+	}
+	return lines
+}
+
+// shootoutSymbol renders the styled symbol for a kick outcome.
+func shootoutSymbol(outcome api.PenaltyOutcome) string {
+	switch outcome {
+	case api.PenaltyScored:
+		return neonScoreStyle.Render(ShootoutSymbolScored)
+	case api.PenaltySaved:
+		return neonDimStyle.Render(ShootoutSymbolSaved)
+	default:
+		return neonDimStyle.Render(ShootoutSymbolMissed)
+	}
+}
@@ -0,0 +1,295 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// StandingsTab identifies the content tab shown in the standings view.
+type StandingsTab int
+
+const (
+	StandingsTabTable StandingsTab = iota
+	StandingsTabLeaders
+	StandingsTabBracket
+)
+
+// standingsTabNames are the tab labels, indexed by StandingsTab.
+var standingsTabNames = []string{"Table", "Leaders", "Bracket"}
+
+// StandingsTabCount is the number of content tabs in the standings view.
+var StandingsTabCount = len(standingsTabNames)
+
+// RenderStandingsView renders the standings view: league tabs, content tabs and
+// the league table, stat leaderboards or knockout bracket for the selected league.
+// scrollOffset scrolls the table and leaderboards; with several table groups it is the first
+// group shown, and in the bracket it is the selected tie.
+// movement and liveTeams mark a projected table (team ID -> places gained, teams playing); both may be nil.
+// fetchErr, when set, replaces the empty-state message for a league that failed to load.
+func RenderStandingsView(width, height int, leagueNames []string, selectedLeague int, tab StandingsTab, tables []api.TableGroup, movement map[int]int, liveTeams map[int]bool, leaders []api.LeaderboardCategory, bracket []api.BracketRound, fetchErr error, randomSpinner *RandomCharSpinner, loading bool, scrollOffset int, bannerType constants.StatusBannerType) string {
+	// Handle edge case: if width/height not set, use defaults
+	if width <= 0 {
+		width = 80
+	}
+	if height <= 0 {
+		height = 24
+	}
+
+	// Reserve 3 lines at top for spinner (always reserve to prevent layout shift)
+	spinnerHeight := 3
+	availableHeight := max(height-spinnerHeight, minPanelHeight)
+
+	spinnerStyle := lipgloss.NewStyle().
+		Width(width).
+		Height(spinnerHeight).
+		Align(lipgloss.Center).
+		AlignVertical(lipgloss.Center)
+
+	var spinnerArea string
+	if loading && randomSpinner != nil {
+		spinnerArea = spinnerStyle.Render(randomSpinner.View())
+	} else {
+		spinnerArea = spinnerStyle.Render("")
+	}
+
+	// League tabs are windowed around the selection so they fit the terminal width
+	names, selected := visibleLeagueTabs(leagueNames, selectedLeague, width)
+	leagueTabs := renderTabBar(names, selected, width)
+	contentTabs := renderTabBar(standingsTabNames, int(tab), width)
+
+	// Leave lines for the two tab bars and the help text
+	panelHeight := availableHeight - 5
+
+	var content string
+	switch {
+	case len(leagueNames) == 0:
+		content = neonEmptyStyle.Width(width - 6).Render(constants.EmptyNoLeagues)
+	case tab == StandingsTabLeaders && len(leaders) > 0:
+		content = renderLeaderboards(leaders, width-6, panelHeight-2, scrollOffset)
+	case tab == StandingsTabBracket && len(bracket) > 0:
+		content = renderBracket(bracket, scrollOffset, width-6, panelHeight-2)
+	case tab == StandingsTabTable && len(tables) == 1 && len(tables[0].Entries) > 0:
+		content = withLiveNote(renderStandingsTable(tables[0].Entries, width-6, panelHeight-3, scrollOffset, movement, liveTeams), liveTeams)
+	case tab == StandingsTabTable && len(tables) > 1:
+		content = withLiveNote(renderTableGroups(tables, width-6, panelHeight-3, scrollOffset, movement, liveTeams), liveTeams)
+	default:
+		message := constants.LoadingFetching
+		if !loading {
+			message = constants.EmptyStandingsUnavailable
+			if fetchErr != nil {
+				message = fmt.Sprintf(constants.ErrorStandingsFetch, fetchErr)
+			}
+		}
+		content = neonEmptyStyle.Width(width - 6).Render(message)
+	}
+
+	panel := neonPanelStyle.Width(width).Height(panelHeight).Render(content)
+
+	help := lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Foreground(neonDim).
+		Render(constants.HelpStandingsView)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		spinnerArea,
+		renderStatusBanner(bannerType, width),
+		leagueTabs,
+		contentTabs,
+		panel,
+		help,
+	)
+}
+
+// visibleLeagueTabs returns the window of league names around selected that fits in width,
+// along with the selected index within that window.
+func visibleLeagueTabs(names []string, selected, width int) ([]string, int) {
+	const tabPadding = 4 // Padding(0, 2) on each tab
+
+	if len(names) == 0 {
+		return nil, 0
+	}
+
+	start, end := selected, selected+1
+	used := lipgloss.Width(names[selected]) + tabPadding
+	for {
+		grew := false
+		if end < len(names) && used+lipgloss.Width(names[end])+tabPadding <= width {
+			used += lipgloss.Width(names[end]) + tabPadding
+			end++
+			grew = true
+		}
+		if start > 0 && used+lipgloss.Width(names[start-1])+tabPadding <= width {
+			start--
+			used += lipgloss.Width(names[start]) + tabPadding
+			grew = true
+		}
+		if !grew {
+			break
+		}
+	}
+	return names[start:end], selected - start
+}
+
+// withLiveNote prefixes a projected table with a note that it reflects live scores.
+func withLiveNote(content string, liveTeams map[int]bool) string {
+	if len(liveTeams) == 0 {
+		return content
+	}
+	return neonLiveStyle.Render("● As it stands") + neonDimStyle.Render(" - live scores applied") + "\n" + content
+}
+
+// renderMovement renders a projected position change as a fixed-width "▲2"/"▼1" marker.
+func renderMovement(teamID int, movement map[int]int) string {
+	moved := movement[teamID]
+	switch {
+	case moved > 0:
+		return neonTeamStyle.Render(fmt.Sprintf("▲%-2d", moved))
+	case moved < 0:
+		return neonScoreStyle.Render(fmt.Sprintf("▼%-2d", -moved))
+	default:
+		return "   "
+	}
+}
+
+// renderTeamName renders a table team name padded to width, marking teams currently playing.
+func renderTeamName(name string, teamID, width int, liveTeams map[int]bool) string {
+	if liveTeams[teamID] {
+		return neonLiveStyle.Render("•") + neonTeamStyle.Render(fmt.Sprintf("%-*s", width-1, truncateString(name, width-1)))
+	}
+	return neonTeamStyle.Render(fmt.Sprintf("%-*s", width, truncateString(name, width)))
+}
+
+// renderStandingsTable renders the league table with a fixed header and scrollable rows.
+// In a projected table, position changes and teams currently playing are marked.
+func renderStandingsTable(table []api.LeagueTableEntry, contentWidth, height, scrollOffset int, movement map[int]int, liveTeams map[int]bool) string {
+	const statsWidth = 34 // Movement plus "  P   W   D   L   GD  Pts" columns
+	nameWidth := max(contentWidth-statsWidth-4, 10)
+
+	header := neonDimStyle.Render(fmt.Sprintf("%3s %3s %-*s %3s %3s %3s %3s %5s %4s",
+		"#", "", nameWidth, "Team", "P", "W", "D", "L", "GD", "Pts"))
+
+	var rows []string
+	for _, entry := range table {
+		rows = append(rows, fmt.Sprintf("%s %s %s %s %s",
+			neonDimStyle.Render(fmt.Sprintf("%3d", entry.Position)),
+			renderMovement(entry.Team.ID, movement),
+			renderTeamName(entry.Team.Name, entry.Team.ID, nameWidth, liveTeams),
+			neonValueStyle.Render(fmt.Sprintf("%3d %3d %3d %3d %+5d", entry.Played, entry.Won, entry.Drawn, entry.Lost, entry.GoalDifference)),
+			neonScoreStyle.Render(fmt.Sprintf("%4d", entry.Points)),
+		))
+	}
+
+	if scrollOffset > 0 && scrollOffset < len(rows) {
+		rows = rows[scrollOffset:]
+	}
+
+	lines := append([]string{header}, rows...)
+	return truncateToHeight(strings.Join(lines, "\n"), height)
+}
+
+// Group table layout: "#  ▲1  Team  P  GD  Pts" columns around the team name.
+const (
+	groupTableStatsWidth = 21
+	groupTableMinWidth   = 34
+	groupTableGap        = 2
+)
+
+// renderTableGroups renders tournament groups side by side, as many per row as fit the width,
+// starting from group firstGroup. Rows of groups that don't fit the height are left for paging.
+func renderTableGroups(groups []api.TableGroup, contentWidth, height, firstGroup int, movement map[int]int, liveTeams map[int]bool) string {
+	firstGroup = max(min(firstGroup, len(groups)-1), 0)
+	perRow := max((contentWidth+groupTableGap)/(groupTableMinWidth+groupTableGap), 1)
+	groupWidth := (contentWidth - groupTableGap*(perRow-1)) / perRow
+
+	var lines []string
+	last := firstGroup
+	for start := firstGroup; start < len(groups); start += perRow {
+		var blocks []string
+		rowHeight := 0
+		end := min(start+perRow, len(groups))
+		for _, group := range groups[start:end] {
+			block := renderGroupTable(group, groupWidth, movement, liveTeams)
+			rowHeight = max(rowHeight, len(block))
+			blocks = append(blocks, strings.Join(block, "\n"))
+		}
+
+		// Stop before a row that would overflow (always show at least one row)
+		if len(lines) > 0 && len(lines)+1+rowHeight > height-1 {
+			break
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+
+		for i := range blocks {
+			blocks[i] = lipgloss.NewStyle().Width(groupWidth).Render(blocks[i])
+			if i > 0 {
+				blocks[i] = lipgloss.NewStyle().PaddingLeft(groupTableGap).Render(blocks[i])
+			}
+		}
+		lines = append(lines, strings.Split(lipgloss.JoinHorizontal(lipgloss.Top, blocks...), "\n")...)
+		last = end
+	}
+
+	if firstGroup > 0 || last < len(groups) {
+		lines = append(lines, neonDimStyle.Render(fmt.Sprintf("Groups %d-%d of %d", firstGroup+1, last, len(groups))))
+	}
+	return truncateToHeight(strings.Join(lines, "\n"), height)
+}
+
+// renderGroupTable renders a compact group table: title, header and one line per team.
+func renderGroupTable(group api.TableGroup, width int, movement map[int]int, liveTeams map[int]bool) []string {
+	nameWidth := max(width-groupTableStatsWidth, 8)
+
+	lines := []string{
+		neonHeaderStyle.Render(truncateString(group.Name, width)),
+		neonDimStyle.Render(fmt.Sprintf("%2s %3s %-*s %3s %4s %4s", "#", "", nameWidth, "Team", "P", "GD", "Pts")),
+	}
+	for _, entry := range group.Entries {
+		lines = append(lines, fmt.Sprintf("%s %s %s %s %s",
+			neonDimStyle.Render(fmt.Sprintf("%2d", entry.Position)),
+			renderMovement(entry.Team.ID, movement),
+			renderTeamName(entry.Team.ShortName, entry.Team.ID, nameWidth, liveTeams),
+			neonValueStyle.Render(fmt.Sprintf("%3d %+4d", entry.Played, entry.GoalDifference)),
+			neonScoreStyle.Render(fmt.Sprintf("%4d", entry.Points)),
+		))
+	}
+	return lines
+}
+
+// renderLeaderboards renders each stat category with its leading players, scrolled by line.
+func renderLeaderboards(categories []api.LeaderboardCategory, contentWidth, height, scrollOffset int) string {
+	var lines []string
+	for i, category := range categories {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, neonHeaderStyle.Render(category.Title))
+		for _, entry := range category.Entries {
+			left := neonDimStyle.Render(fmt.Sprintf("%2d. ", entry.Rank)) +
+				neonValueStyle.Render(entry.PlayerName) +
+				neonDimStyle.Render("  "+entry.Team.Name)
+			lines = append(lines, renderTwoColumns(left, neonScoreStyle.Render(formatLeaderValue(entry.Value)), contentWidth))
+		}
+	}
+
+	if scrollOffset > 0 && scrollOffset < len(lines) {
+		lines = lines[scrollOffset:]
+	}
+	return truncateToHeight(strings.Join(lines, "\n"), height)
+}
+
+// formatLeaderValue formats a leaderboard value: counts as integers, ratings with two decimals.
+func formatLeaderValue(value float64) string {
+	if value == math.Trunc(value) {
+		return fmt.Sprintf("%d", int(value))
+	}
+	return fmt.Sprintf("%.2f", value)
+}
@@ -0,0 +1,232 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderTeamView renders the team page: overview, results and fixtures on the left,
+// top scorers and squad on the right.
+// scrollOffset scrolls the squad list; the rest of the page is fixed.
+func RenderTeamView(width, height int, team *api.TeamDetails, randomSpinner *RandomCharSpinner, loading bool, scrollOffset int, bannerType constants.StatusBannerType) string {
+	// Handle edge case: if width/height not set, use defaults
+	if width <= 0 {
+		width = 80
+	}
+	if height <= 0 {
+		height = 24
+	}
+
+	// Reserve 3 lines at top for spinner (always reserve to prevent layout shift)
+	spinnerHeight := 3
+	availableHeight := max(height-spinnerHeight, minPanelHeight)
+
+	spinnerStyle := lipgloss.NewStyle().
+		Width(width).
+		Height(spinnerHeight).
+		Align(lipgloss.Center).
+		AlignVertical(lipgloss.Center)
+
+	var spinnerArea string
+	if loading && randomSpinner != nil {
+		spinnerArea = spinnerStyle.Render(randomSpinner.View())
+	} else {
+		spinnerArea = spinnerStyle.Render("")
+	}
+
+	// Calculate panel dimensions (50/50 split)
+	leftWidth := max(width/2, 35)
+	rightWidth := max(width-leftWidth-1, 30) // -1 for separator
+
+	// Leave a line for the help text
+	panelHeight := availableHeight - 3
+
+	var leftPanel, rightPanel string
+	if team == nil {
+		message := constants.LoadingFetching
+		if !loading {
+			message = constants.EmptyTeamUnavailable
+		}
+		leftPanel = neonPanelStyle.Width(leftWidth).Height(panelHeight).Render(
+			neonEmptyStyle.Width(leftWidth - 6).Render(message),
+		)
+		rightPanel = neonPanelCyanStyle.Width(rightWidth).Height(panelHeight).Render("")
+	} else {
+		leftPanel = renderTeamOverviewPanel(leftWidth, panelHeight, team)
+		rightPanel = renderTeamSquadPanel(rightWidth, panelHeight, team, scrollOffset)
+	}
+
+	separator := neonSeparatorStyle.Height(panelHeight).Render("┃")
+
+	panels := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		leftPanel,
+		separator,
+		rightPanel,
+	)
+
+	help := lipgloss.NewStyle().
+		Width(width).
+		Align(lipgloss.Center).
+		Foreground(neonDim).
+		Render(constants.HelpTeamView)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		spinnerArea,
+		renderStatusBanner(bannerType, width),
+		panels,
+		help,
+	)
+}
+
+// renderTeamOverviewPanel renders the team header, league position, recent results and fixtures.
+func renderTeamOverviewPanel(width, height int, team *api.TeamDetails) string {
+	contentWidth := width - 6
+
+	var lines []string
+	lines = append(lines, neonPanelTitleStyle.Width(contentWidth).Render(team.Name))
+
+	if team.Country != "" {
+		lines = append(lines, neonDimStyle.Render(team.Country))
+	}
+
+	if entry := team.TablePosition; entry != nil {
+		position := neonValueStyle.Render(ordinal(entry.Position)) +
+			neonDimStyle.Render(fmt.Sprintf(" in %s • %d pts • P%d W%d D%d L%d",
+				team.TableLeague.Name, entry.Points, entry.Played, entry.Won, entry.Drawn, entry.Lost))
+		lines = append(lines, lipgloss.NewStyle().Width(contentWidth).MaxHeight(1).Render(position))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, neonHeaderStyle.Render("Recent Results"))
+	if len(team.RecentResults) == 0 {
+		lines = append(lines, neonDimStyle.Render(constants.EmptyNoMatches))
+	}
+	for _, match := range team.RecentResults {
+		lines = append(lines, formatTeamMatchLine(match, team.ID, contentWidth))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, neonHeaderStyle.Render("Fixtures"))
+	if len(team.UpcomingFixtures) == 0 {
+		lines = append(lines, neonDimStyle.Render(constants.EmptyNoMatches))
+	}
+	for _, match := range team.UpcomingFixtures {
+		lines = append(lines, formatTeamMatchLine(match, team.ID, contentWidth))
+	}
+
+	content := truncateToHeight(strings.Join(lines, "\n"), height-2)
+	return neonPanelStyle.Width(width).Height(height).Render(content)
+}
+
+// renderTeamSquadPanel renders top scorers (fixed) and the squad (scrollable).
+func renderTeamSquadPanel(width, height int, team *api.TeamDetails, scrollOffset int) string {
+	contentWidth := width - 4
+
+	var lines []string
+	if len(team.TopScorers) > 0 {
+		lines = append(lines, neonHeaderStyle.Render("Top Scorers"))
+		for _, scorer := range team.TopScorers {
+			lines = append(lines, renderTwoColumns(
+				neonValueStyle.Render(scorer.Name),
+				neonScoreStyle.Render(fmt.Sprintf("%d", scorer.Goals)),
+				contentWidth,
+			))
+		}
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, neonPanelTitleStyle.Width(contentWidth).Render(constants.PanelSquad))
+
+	// Squad lines, grouped by position
+	var squadLines []string
+	lastPosition := ""
+	for _, p := range team.Squad {
+		if p.Position != lastPosition {
+			squadLines = append(squadLines, neonDimStyle.Italic(true).Render(p.Position))
+			lastPosition = p.Position
+		}
+		squadLines = append(squadLines, formatSquadPlayer(p, contentWidth))
+	}
+
+	if scrollOffset > 0 && scrollOffset < len(squadLines) {
+		squadLines = squadLines[scrollOffset:]
+	}
+	lines = append(lines, squadLines...)
+
+	content := truncateToHeight(strings.Join(lines, "\n"), height)
+	return neonPanelCyanStyle.Width(width).Height(height).Render(content)
+}
+
+// formatTeamMatchLine formats a result or fixture from the team's perspective:
+// "12/01  W 2-1  vs Chelsea  Premier League" or "18/01  20:00  at Arsenal  FA Cup".
+func formatTeamMatchLine(match api.Match, teamID int, contentWidth int) string {
+	isHome := match.HomeTeam.ID == teamID
+	opponent := match.AwayTeam.Name
+	venue := "vs"
+	if !isHome {
+		opponent = match.HomeTeam.Name
+		venue = "at"
+	}
+
+	dateStr := "--/--"
+	if match.MatchTime != nil {
+		dateStr = match.MatchTime.Local().Format("02/01")
+	}
+
+	var outcome string
+	if match.HomeScore != nil && match.AwayScore != nil {
+		teamGoals, oppGoals := *match.HomeScore, *match.AwayScore
+		if !isHome {
+			teamGoals, oppGoals = oppGoals, teamGoals
+		}
+		result, style := "D", neonDimStyle
+		switch {
+		case match.Status == api.MatchStatusLive:
+			result, style = "•", neonLiveStyle
+		case teamGoals > oppGoals:
+			result, style = "W", neonTeamStyle
+		case teamGoals < oppGoals:
+			result, style = "L", neonScoreStyle
+		}
+		outcome = style.Render(fmt.Sprintf("%s %d-%d", result, teamGoals, oppGoals))
+	} else if match.MatchTime != nil {
+		outcome = neonDimStyle.Render(match.MatchTime.Local().Format("15:04"))
+	}
+
+	line := fmt.Sprintf("%s  %s  %s %s  %s",
+		neonDimStyle.Render(dateStr),
+		lipgloss.NewStyle().Width(6).Render(outcome),
+		neonDimStyle.Render(venue),
+		neonValueStyle.Render(opponent),
+		neonDimStyle.Italic(true).Render(match.League.Name),
+	)
+	return lipgloss.NewStyle().Width(contentWidth).MaxHeight(1).Render(line)
+}
+
+// formatSquadPlayer formats a squad member as "10  Name  (Country, 24)".
+func formatSquadPlayer(p api.SquadPlayer, contentWidth int) string {
+	number := "  "
+	if p.Number > 0 {
+		number = fmt.Sprintf("%2d", p.Number)
+	}
+
+	var detail []string
+	if p.Country != "" {
+		detail = append(detail, p.Country)
+	}
+	if p.Age > 0 {
+		detail = append(detail, fmt.Sprintf("%d", p.Age))
+	}
+
+	line := neonDimStyle.Render(number+"  ") + neonValueStyle.Render(p.Name)
+	if len(detail) > 0 {
+		line += neonDimStyle.Render("  (" + strings.Join(detail, ", ") + ")")
+	}
+	return lipgloss.NewStyle().Width(contentWidth).MaxHeight(1).Render(line)
+}
@@ -0,0 +1,181 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TimelineInput is the text field being edited in the timeline filter bar.
+type TimelineInput int
+
+const (
+	TimelineInputNone   TimelineInput = iota
+	TimelineInputPlayer               // "/" player name search
+	TimelineInputMinute               // "g" jump to minute
+)
+
+// TimelineFilter narrows the event timelines in the match details panels.
+// Category toggles combine (goals + cards shows both); with none set every event is shown.
+type TimelineFilter struct {
+	Goals bool // Goals, own goals, penalties and missed penalties
+	Cards bool
+	Subs  bool
+	VAR   bool
+
+	Player     string // Case-insensitive substring of any player involved
+	FromMinute int    // Timelines start at this minute (0 = whole match)
+
+	Input      TimelineInput // Field being edited, if any
+	InputValue string
+
+	Focused bool // Details panel has focus: the bar is shown with key hints
+}
+
+// Active reports whether the filter hides any events.
+func (f TimelineFilter) Active() bool {
+	return f.Goals || f.Cards || f.Subs || f.VAR || f.Player != "" || f.FromMinute > 0
+}
+
+// Shown reports whether the filter bar is displayed.
+func (f TimelineFilter) Shown() bool {
+	return f.Focused || f.Active() || f.Input != TimelineInputNone
+}
+
+// Toggle flips a category by its key ("1" goals, "2" cards, "3" subs, "4" VAR)
+// and reports whether the key was a category key.
+func (f *TimelineFilter) Toggle(key string) bool {
+	switch key {
+	case "1":
+		f.Goals = !f.Goals
+	case "2":
+		f.Cards = !f.Cards
+	case "3":
+		f.Subs = !f.Subs
+	case "4":
+		f.VAR = !f.VAR
+	default:
+		return false
+	}
+	return true
+}
+
+// ClearMatch resets the match-specific parts of the filter (player search and minute),
+// keeping the category toggles for the rest of the session.
+func (f *TimelineFilter) ClearMatch() {
+	f.Player = ""
+	f.FromMinute = 0
+	f.Input = TimelineInputNone
+	f.InputValue = ""
+}
+
+// showsKind reports whether events of the given kind pass the category toggles.
+func (f TimelineFilter) showsKind(kind api.EventKind) bool {
+	if !f.Goals && !f.Cards && !f.Subs && !f.VAR {
+		return true
+	}
+	switch {
+	case kind.IsGoal(), kind == api.EventMissedPenalty:
+		return f.Goals
+	case kind.IsCard():
+		return f.Cards
+	case kind == api.EventSubstitution:
+		return f.Subs
+	case kind == api.EventVAR:
+		return f.VAR
+	default:
+		return false
+	}
+}
+
+// showsPlayer reports whether one of the named players matches the player search.
+func (f TimelineFilter) showsPlayer(names ...string) bool {
+	if f.Player == "" {
+		return true
+	}
+	query := strings.ToLower(f.Player)
+	for _, name := range names {
+		if name != "" && strings.Contains(strings.ToLower(name), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesEvent reports whether a match event passes the filter.
+// Event lists read oldest first, so jumping to a minute hides earlier events.
+func (f TimelineFilter) MatchesEvent(event api.MatchEvent) bool {
+	if f.FromMinute > 0 && event.Minute < f.FromMinute {
+		return false
+	}
+	return f.showsKind(event.Kind) &&
+		f.showsPlayer(derefString(event.Player), derefString(event.PlayerIn), derefString(event.Assist))
+}
+
+// MatchesEntry reports whether a live timeline entry passes the filter.
+// The live feed reads newest first, so jumping to a minute hides later entries.
+func (f TimelineFilter) MatchesEntry(entry api.TimelineEntry) bool {
+	if f.FromMinute > 0 && entry.Minute > f.FromMinute {
+		return false
+	}
+	return f.showsKind(entry.Kind) && f.showsPlayer(entry.Player, entry.PlayerIn, entry.Assist)
+}
+
+// filterEvents returns the events that pass the filter.
+func filterEvents(events []api.MatchEvent, filter TimelineFilter, keep func(api.MatchEvent) bool) []api.MatchEvent {
+	var filtered []api.MatchEvent
+	for _, event := range events {
+		if keep(event) && filter.MatchesEvent(event) {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
+// renderTimelineFilterBar renders the category toggles and active search, or the input being edited.
+// Returns "" when the bar is not shown.
+func renderTimelineFilterBar(filter TimelineFilter, width int) string {
+	if filter.Input != TimelineInputNone {
+		prompt := "Player: "
+		if filter.Input == TimelineInputMinute {
+			prompt = "Jump to minute: "
+		}
+		return neonDimStyle.Render(prompt) + neonValueStyle.Render(filter.InputValue) + neonLiveStyle.Render("▏")
+	}
+	if !filter.Shown() {
+		return ""
+	}
+
+	toggle := func(key, label string, on bool) string {
+		if on {
+			return lipgloss.NewStyle().Foreground(neonCyan).Bold(true).Render(key + " " + label)
+		}
+		return neonDimStyle.Render(key + " " + label)
+	}
+	parts := []string{
+		toggle("1", "Goals", filter.Goals),
+		toggle("2", "Cards", filter.Cards),
+		toggle("3", "Subs", filter.Subs),
+		toggle("4", "VAR", filter.VAR),
+	}
+	if filter.Player != "" {
+		parts = append(parts, neonValueStyle.Render("/"+filter.Player))
+	}
+	if filter.FromMinute > 0 {
+		parts = append(parts, neonValueStyle.Render(fmt.Sprintf("from %d'", filter.FromMinute)))
+	}
+	if filter.Focused {
+		parts = append(parts, neonDimStyle.Render("/ player  g minute  0 clear"))
+	}
+	return lipgloss.NewStyle().MaxWidth(width).Render(strings.Join(parts, "  "))
+}
+
+// derefString returns the string s points to, or "" for nil.
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -28,6 +28,14 @@ func renderStatusBanner(bannerType constants.StatusBannerType, width int) string
 		message = "New Version Available! Run 'golazo --update'"
 	case constants.StatusBannerDev:
 		message = "[DEV BUILD] This is a development version"
+	case constants.StatusBannerSnoozed:
+		message = "[SNOOZED] Notifications muted for 30 minutes - z to change"
+	case constants.StatusBannerSnoozedMatch:
+		message = "[SNOOZED] Notifications muted until this match ends - z to unmute"
+	case constants.StatusBannerQuietHours:
+		message = "[QUIET HOURS] Notifications muted"
+	case constants.StatusBannerHookFailed:
+		message = "[HOOK FAILED] A notification command failed - details in the debug log (--debug)"
 	case constants.StatusBannerNone:
 		fallthrough
 	default:
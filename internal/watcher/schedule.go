@@ -0,0 +1,85 @@
+package watcher
+
+import (
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// Live list refresh cadence. The list is re-read at each kickoff, often around
+// half-time and full-time, and rarely when nothing is on.
+const (
+	LiveRefreshInterval       = 5 * time.Minute  // Matches in play, none about to change phase
+	TransitionRefreshInterval = 1 * time.Minute  // Closing minutes of a half, half-time and shootouts
+	KickoffRetryInterval      = 30 * time.Second // Kickoff time passed but the match isn't live yet
+	IdleRefreshInterval       = 30 * time.Minute // Nothing in play and nothing left to kick off
+)
+
+const (
+	// kickoffLateness is how long after its kickoff time a match is still expected to go live.
+	kickoffLateness = 15 * time.Minute
+	// minRefreshDelay keeps a kickoff a few seconds away from scheduling a burst of refreshes.
+	minRefreshDelay = 5 * time.Second
+)
+
+// NextRefresh returns how long to wait before re-reading the live matches list,
+// given the matches in play and today's matches still to kick off.
+func NextRefresh(now time.Time, live, upcoming []api.Match) time.Duration {
+	delay := IdleRefreshInterval
+
+	for _, match := range live {
+		if nearTransition(match.State) {
+			delay = min(delay, TransitionRefreshInterval)
+		} else {
+			delay = min(delay, LiveRefreshInterval)
+		}
+	}
+
+	for _, match := range upcoming {
+		if match.MatchTime == nil || match.Status == api.MatchStatusPostponed {
+			continue
+		}
+		untilKickoff := match.MatchTime.Sub(now)
+		switch {
+		case untilKickoff > 0:
+			delay = min(delay, untilKickoff)
+		case -untilKickoff < kickoffLateness:
+			delay = min(delay, KickoffRetryInterval)
+		}
+	}
+
+	return max(delay, minRefreshDelay)
+}
+
+// nextDiscovery returns how long the watcher waits before re-reading today's matches,
+// given those it doesn't watch yet: until the next of them comes within WatchAhead of
+// kickoff, or IdleRefreshInterval when none will, and never less than DiscoveryInterval.
+func nextDiscovery(now time.Time, pending []api.Match) time.Duration {
+	delay := IdleRefreshInterval
+	for _, match := range pending {
+		if match.MatchTime == nil || match.State.Phase != api.PhasePreMatch {
+			continue
+		}
+		delay = min(delay, match.MatchTime.Add(-WatchAhead).Sub(now))
+	}
+	return max(delay, DiscoveryInterval)
+}
+
+// nearTransition reports whether a match is about to change phase:
+// the closing minutes of a half, a break, or a shootout in progress.
+func nearTransition(state api.MatchState) bool {
+	switch state.Phase {
+	case api.PhaseHalfTime, api.PhaseExtraTimeBreak, api.PhasePenalties:
+		return true
+	case api.PhaseFirstHalf:
+		return state.Minute >= 43
+	case api.PhaseSecondHalf:
+		return state.Minute >= 87
+	case api.PhaseExtraTimeFirst:
+		return state.Minute >= 103
+	case api.PhaseExtraTimeSecond:
+		return state.Minute >= 118
+	default:
+		return false
+	}
+}
@@ -0,0 +1,108 @@
+package watcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestNextRefresh(t *testing.T) {
+	now := time.Date(2026, 3, 14, 14, 50, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		kickoff := now.Add(d)
+		return &kickoff
+	}
+	playing := func(phase api.MatchPhase, minute int) api.Match {
+		return api.Match{Status: api.MatchStatusLive, State: api.MatchState{Phase: phase, Minute: minute}}
+	}
+	upcoming := func(kickoff *time.Time) api.Match {
+		return api.Match{Status: api.MatchStatusNotStarted, MatchTime: kickoff}
+	}
+
+	tests := []struct {
+		desc     string
+		live     []api.Match
+		upcoming []api.Match
+		want     time.Duration
+	}{
+		{
+			desc: "nothing on",
+			want: IdleRefreshInterval,
+		},
+		{
+			desc:     "wakes at the next kickoff",
+			upcoming: []api.Match{upcoming(at(3 * time.Hour)), upcoming(at(10 * time.Minute))},
+			want:     10 * time.Minute,
+		},
+		{
+			desc: "live match mid-half",
+			live: []api.Match{playing(api.PhaseSecondHalf, 60)},
+			want: LiveRefreshInterval,
+		},
+		{
+			desc:     "kickoff sooner than the live interval",
+			live:     []api.Match{playing(api.PhaseFirstHalf, 20)},
+			upcoming: []api.Match{upcoming(at(2 * time.Minute))},
+			want:     2 * time.Minute,
+		},
+		{
+			desc: "closing minutes",
+			live: []api.Match{playing(api.PhaseFirstHalf, 20), playing(api.PhaseSecondHalf, 89)},
+			want: TransitionRefreshInterval,
+		},
+		{
+			desc: "half-time",
+			live: []api.Match{playing(api.PhaseHalfTime, 45)},
+			want: TransitionRefreshInterval,
+		},
+		{
+			desc:     "kickoff passed, match not live yet",
+			upcoming: []api.Match{upcoming(at(-2 * time.Minute))},
+			want:     KickoffRetryInterval,
+		},
+		{
+			desc:     "long overdue and postponed matches are ignored",
+			upcoming: []api.Match{upcoming(at(-time.Hour)), {Status: api.MatchStatusPostponed, MatchTime: at(time.Minute)}},
+			want:     IdleRefreshInterval,
+		},
+		{
+			desc:     "imminent kickoff is floored",
+			upcoming: []api.Match{upcoming(at(time.Second))},
+			want:     minRefreshDelay,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := NextRefresh(now, tt.live, tt.upcoming); got != tt.want {
+			t.Errorf("%s: NextRefresh() = %v; want %v", tt.desc, got, tt.want)
+		}
+	}
+}
+
+func TestNextDiscovery(t *testing.T) {
+	now := time.Date(2026, 3, 14, 14, 50, 0, 0, time.UTC)
+	kickoff := func(d time.Duration) api.Match {
+		at := now.Add(d)
+		return api.Match{MatchTime: &at, State: api.MatchState{Phase: api.PhasePreMatch}}
+	}
+	finished := kickoff(-2 * time.Hour)
+	finished.State.Phase = api.PhaseFullTime
+
+	tests := []struct {
+		desc    string
+		pending []api.Match
+		want    time.Duration
+	}{
+		{"nothing left to watch", nil, IdleRefreshInterval},
+		{"finished matches don't count", []api.Match{finished}, IdleRefreshInterval},
+		{"next match comes within WatchAhead", []api.Match{kickoff(5 * time.Hour), kickoff(WatchAhead + 20*time.Minute)}, 20 * time.Minute},
+		{"kickoffs far off wait the idle interval", []api.Match{kickoff(5 * time.Hour)}, IdleRefreshInterval},
+		{"floored at the discovery interval", []api.Match{kickoff(WatchAhead + time.Second)}, DiscoveryInterval},
+	}
+	for _, tt := range tests {
+		if got := nextDiscovery(now, tt.pending); got != tt.want {
+			t.Errorf("%s: nextDiscovery() = %v; want %v", tt.desc, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,548 @@
+// Package watcher follows every live match in the followed leagues and of the followed
+// teams in the background, and those about to kick off, so match notifications and list scores don't depend
+// on which match is on screen.
+package watcher
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/notify"
+)
+
+// DiscoveryInterval is the shortest wait between reads of today's matches, and the wait after a failed one.
+// Otherwise they are re-read when the next match comes within WatchAhead (see nextDiscovery).
+const DiscoveryInterval = 2 * time.Minute
+
+// WatchAhead is how long before kickoff a match is watched, to announce its lineups
+// (usually out an hour before) and catch late postponements.
+const WatchAhead = 90 * time.Minute
+
+// Source provides the live data the watcher follows.
+type Source interface {
+	// TodayMatches returns today's matches in the followed leagues and the followed teams'
+	// next matches in any competition, at least those in play and those yet to kick off.
+	TodayMatches(ctx context.Context) ([]api.Match, error)
+	// Watch streams a match's updates until it ends (see fotmob.Client.Watch).
+	Watch(ctx context.Context, matchID int) <-chan fotmob.MatchUpdate
+}
+
+// fotmobSource polls FotMob, bypassing the client cache.
+type fotmobSource struct {
+	client *fotmob.Client
+}
+
+// NewFotMobSource creates a source backed by the FotMob client.
+func NewFotMobSource(client *fotmob.Client) Source {
+	return fotmobSource{client: client}
+}
+
+func (s fotmobSource) TodayMatches(ctx context.Context) ([]api.Match, error) {
+	// A single tab isn't cached, so this is always fresh
+	matches, err := s.client.MatchesByDateWithTabs(ctx, time.Now(), []string{"fixtures"})
+	if err != nil {
+		return nil, err
+	}
+
+	// Followed teams also play outside the followed leagues (cups, friendlies).
+	// A team that fails to load is skipped; the next discovery tries it again.
+	settings, err := data.LoadSettings()
+	if err != nil {
+		return matches, nil
+	}
+	for _, team := range settings.FollowedTeams {
+		next, err := s.client.TeamNextMatch(ctx, team.ID)
+		if err != nil || next == nil {
+			continue
+		}
+		if !slices.ContainsFunc(matches, func(m api.Match) bool { return m.ID == next.ID }) {
+			matches = append(matches, *next)
+		}
+	}
+	return matches, nil
+}
+
+func (s fotmobSource) Watch(ctx context.Context, matchID int) <-chan fotmob.MatchUpdate {
+	return s.client.Watch(ctx, matchID)
+}
+
+// mockSource serves the mock live matches.
+type mockSource struct{}
+
+// NewMockSource creates a source backed by the mock data.
+func NewMockSource() Source {
+	return mockSource{}
+}
+
+func (mockSource) TodayMatches(ctx context.Context) ([]api.Match, error) {
+	return data.MockLiveMatches(), nil
+}
+
+func (mockSource) Watch(ctx context.Context, matchID int) <-chan fotmob.MatchUpdate {
+	return fotmob.WatchWith(ctx, matchID, mockDetails)
+}
+
+// mockDetails serves the mock match details as a fotmob.DetailsFetcher.
+func mockDetails(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+	return data.MockMatchDetails(matchID)
+}
+
+// Update is what one poll of a watched match found.
+type Update struct {
+	Details *api.MatchDetails
+	Goals   []api.MatchEvent // Goals scored since the last poll, in match order
+
+	// Goals ruled out since the last poll (VAR, score corrections), as last seen.
+	// A score drop with no goal to name is reported as an event carrying only the team.
+	Disallowed []api.MatchEvent
+
+	RedCards []api.MatchEvent // Players sent off since the last poll
+
+	// Penalties given (VAR decisions) and missed since the last poll.
+	// Penalties scored are goals.
+	PenaltiesAwarded []api.MatchEvent
+	PenaltiesMissed  []api.MatchEvent
+
+	Lineups bool                // Confirmed lineups were announced since the last poll, before kickoff
+	Kickoff bool                // The match kicked off since the last poll, or just before it was found
+	Phase   *fotmob.PhaseChange // The match changed phase since the last poll
+
+	Ended bool // The match is over; the watcher stops polling it
+}
+
+// kickoffGrace is how far into the first half a newly found match still announces its kickoff.
+// Matches are found from the live list, so usually a few minutes after they kick off.
+const kickoffGrace = 5
+
+// NotifyEvents returns what the update has to announce, in match order: lineups, kickoff,
+// penalties given, disallowed goals, goals, missed penalties, red cards, then half-time,
+// full-time or a postponement.
+// Disallowed goals go before goals, so a goal ruled out and a goal scored in the same poll read in order.
+func (u Update) NotifyEvents() []notify.Event {
+	if u.Details == nil {
+		return nil
+	}
+	match := u.Details.Match
+	home, away := u.Score()
+	event := func(kind notify.Kind, matchEvent api.MatchEvent) notify.Event {
+		return notify.Event{Kind: kind, Match: match, Event: matchEvent, HomeScore: home, AwayScore: away}
+	}
+
+	var events []notify.Event
+	if u.Lineups {
+		events = append(events, event(notify.KindLineups, api.MatchEvent{}))
+	}
+	if u.Kickoff {
+		events = append(events, event(notify.KindKickoff, api.MatchEvent{}))
+	}
+	for _, penalty := range u.PenaltiesAwarded {
+		events = append(events, event(notify.KindPenaltyAwarded, penalty))
+	}
+	for _, disallowed := range u.Disallowed {
+		events = append(events, event(notify.KindVAR, disallowed))
+	}
+	for _, goal := range u.Goals {
+		events = append(events, event(notify.KindGoal, goal))
+	}
+	for _, penalty := range u.PenaltiesMissed {
+		events = append(events, event(notify.KindPenaltyMissed, penalty))
+	}
+	for _, card := range u.RedCards {
+		events = append(events, event(notify.KindRedCard, card))
+	}
+	if u.Phase != nil {
+		switch u.Phase.To {
+		case api.PhaseHalfTime:
+			events = append(events, event(notify.KindHalfTime, api.MatchEvent{}))
+		case api.PhaseFullTime, api.PhaseAfterExtraTime, api.PhaseAfterPenalties:
+			events = append(events, event(notify.KindFullTime, api.MatchEvent{}))
+		case api.PhasePostponed:
+			events = append(events, event(notify.KindPostponed, api.MatchEvent{}))
+		}
+	}
+	return events
+}
+
+// Score returns the polled score, with a missing score as 0.
+func (u Update) Score() (home, away int) {
+	if u.Details == nil {
+		return 0, 0
+	}
+	if u.Details.HomeScore != nil {
+		home = *u.Details.HomeScore
+	}
+	if u.Details.AwayScore != nil {
+		away = *u.Details.AwayScore
+	}
+	return home, away
+}
+
+// tracked is the watcher's state for one live match.
+type tracked struct {
+	events  []api.MatchEvent // As of the previous update
+	lineups bool             // Confirmed lineups were seen
+
+	// Per side (home, away): goals ruled out by event minus unexplained score drops.
+	// FotMob can lower the score a poll before or after removing the goal event,
+	// and the pair must only be announced once.
+	corrections [2]int
+}
+
+// subscriberBuffer is how many stream updates a subscriber can fall behind before updates are dropped for it.
+const subscriberBuffer = 16
+
+// watch is a followed match: its latest successful poll and who else reads its stream.
+type watch struct {
+	last        *api.MatchDetails
+	subscribers map[chan fotmob.MatchUpdate]bool
+}
+
+// Watcher follows the live matches in the background and sends an Update for every poll.
+type Watcher struct {
+	source  Source
+	updates chan Update
+
+	mu       sync.Mutex
+	ctx      context.Context // Set by Start; watches run until it is cancelled
+	wg       sync.WaitGroup  // Running watches
+	watching map[int]*watch
+}
+
+// New creates a watcher for the source. Call Start to begin polling.
+func New(source Source) *Watcher {
+	return &Watcher{
+		source:   source,
+		updates:  make(chan Update, 16),
+		watching: make(map[int]*watch),
+	}
+}
+
+// Updates returns the channel the watcher's polls are sent on.
+// It is closed when the watcher stops.
+func (w *Watcher) Updates() <-chan Update {
+	return w.updates
+}
+
+// Start polls in a background goroutine until ctx is cancelled.
+func (w *Watcher) Start(ctx context.Context) {
+	w.mu.Lock()
+	w.ctx = ctx
+	w.mu.Unlock()
+	go w.run(ctx)
+}
+
+// Subscribe streams a match's polls as they are made, starting with its latest poll as
+// the initial snapshot, so a view showing the match doesn't poll it a second time.
+// The match is followed from now on if it wasn't already. The channel is closed when
+// the watch ends; cancel stops the subscription. Updates a subscriber is too slow for are dropped.
+// Before Start, or once the watcher has stopped, the channel is closed straight away.
+func (w *Watcher) Subscribe(matchID int) (updates <-chan fotmob.MatchUpdate, cancel func()) {
+	ch := make(chan fotmob.MatchUpdate, subscriberBuffer)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.ctx == nil || w.ctx.Err() != nil {
+		close(ch)
+		return ch, func() {}
+	}
+
+	wt := w.watching[matchID]
+	if wt == nil {
+		wt = w.startLocked(matchID)
+	} else if wt.last != nil {
+		ch <- fotmob.NewMatchUpdate(nil, wt.last)
+	}
+	wt.subscribers[ch] = true
+
+	return ch, func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		if wt.subscribers[ch] {
+			delete(wt.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// run re-reads the live list periodically, watching each match that kicked off.
+func (w *Watcher) run(ctx context.Context) {
+	defer func() {
+		w.wg.Wait()
+		close(w.updates)
+	}()
+
+	for {
+		delay := w.discover(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// discover starts a watch for each match in play or kicking off within WatchAhead
+// that isn't watched yet, and returns how long to wait before the next discovery.
+// A watch ends by itself once its match is over.
+func (w *Watcher) discover(ctx context.Context) time.Duration {
+	// No deadline on the whole list: it is one request per followed league and team, taking turns
+	// in the client's rate limiter, and each request has its own timeout in the client.
+	matches, err := w.source.TodayMatches(ctx)
+	if err != nil {
+		return DiscoveryInterval
+	}
+
+	now := time.Now()
+	var pending []api.Match // Not watched yet
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, match := range matches {
+		if w.watching[match.ID] != nil {
+			continue
+		}
+		if !watchable(match, now) {
+			pending = append(pending, match)
+			continue
+		}
+		w.startLocked(match.ID)
+	}
+	return nextDiscovery(now, pending)
+}
+
+// startLocked starts following a match. w.mu must be held, and Start called.
+func (w *Watcher) startLocked(matchID int) *watch {
+	wt := &watch{subscribers: make(map[chan fotmob.MatchUpdate]bool)}
+	w.watching[matchID] = wt
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		w.follow(w.ctx, matchID, wt)
+	}()
+	return wt
+}
+
+// watchable reports whether a match is in play or kicks off within WatchAhead of now.
+func watchable(match api.Match, now time.Time) bool {
+	if match.State.InPlay() {
+		return true
+	}
+	return match.State.Phase == api.PhasePreMatch && match.MatchTime != nil && match.MatchTime.Sub(now) <= WatchAhead
+}
+
+// follow forwards a match's stream as updates until the match ends or ctx is cancelled,
+// and passes every poll on to the match's subscribers.
+// Failed polls are only passed on; the stream retries them.
+func (w *Watcher) follow(ctx context.Context, matchID int, wt *watch) {
+	defer func() {
+		w.mu.Lock()
+		delete(w.watching, matchID)
+		for ch := range wt.subscribers {
+			close(ch)
+		}
+		wt.subscribers = nil
+		w.mu.Unlock()
+	}()
+
+	var t tracked
+	for polled := range w.source.Watch(ctx, matchID) {
+		w.publish(wt, polled)
+		if polled.Err != nil {
+			continue
+		}
+		select {
+		case w.updates <- t.observe(polled):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// publish keeps a poll as the match's latest and sends it to the subscribers with room for it.
+func (w *Watcher) publish(wt *watch, polled fotmob.MatchUpdate) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if polled.Err == nil {
+		wt.last = polled.Details
+	}
+	for ch := range wt.subscribers {
+		select {
+		case ch <- polled:
+		default:
+		}
+	}
+}
+
+// observe turns one update of the match stream into the watcher's announcements.
+// The initial snapshot sets the baseline and announces nothing.
+func (t *tracked) observe(polled fotmob.MatchUpdate) Update {
+	details := polled.Details
+	update := Update{Details: details, Ended: polled.Ended}
+
+	if polled.Initial {
+		update.Kickoff = details.State.Phase == api.PhaseFirstHalf && details.State.Minute <= kickoffGrace
+	} else {
+		update.Phase = polled.Phase
+		update.Kickoff = polled.Phase != nil && polled.Phase.From == api.PhasePreMatch && details.State.InPlay()
+		var change [2]int // Score change per side
+		if polled.Score != nil {
+			change = [2]int{polled.Score.Home - polled.Score.PrevHome, polled.Score.Away - polled.Score.PrevAway}
+		}
+		update.Disallowed = t.disallowed(polled.Events, details, change)
+		// Postponed, abandoned or cancelled matches don't announce goals
+		if details.State.InPlay() || details.Status == api.MatchStatusFinished {
+			update.Goals = t.goals(polled.Events, details, change)
+		}
+		update.RedCards = t.redCards(polled.Events)
+		update.PenaltiesAwarded, update.PenaltiesMissed = penalties(polled.Events)
+	}
+
+	// Lineups are only news before kickoff
+	confirmed := len(details.HomeStarting) > 0 && !details.LineupPredicted
+	update.Lineups = confirmed && !t.lineups && details.State.Phase == api.PhasePreMatch
+	t.lineups = confirmed
+
+	t.events = details.Events
+	return update
+}
+
+// goals returns the goals behind a score increase, per side the latest ones added since the last poll.
+// A side whose score went up without a new goal event gets its latest goal, as the live view always did.
+func (t *tracked) goals(diff fotmob.EventDiff, details *api.MatchDetails, change [2]int) []api.MatchEvent {
+	added := append(append([]api.MatchEvent{}, diff.Added...), diff.Modified...)
+
+	var goals []api.MatchEvent
+	for side, increase := range change {
+		if increase <= 0 {
+			continue
+		}
+		team := teamFor(details, side)
+
+		var scored []api.MatchEvent
+		for _, event := range added {
+			if event.Kind.IsGoal() && event.Team.ID == team.ID && !wasGoal(t.events, event.ID) {
+				scored = append(scored, event)
+			}
+		}
+		if len(scored) == 0 {
+			if latest, ok := latestGoal(details.Events, team); ok {
+				scored = append(scored, latest)
+			}
+		}
+		if len(scored) > increase {
+			scored = scored[len(scored)-increase:]
+		}
+		goals = append(goals, scored...)
+	}
+	return goals
+}
+
+// disallowed returns the goals ruled out since the last poll, settling each against
+// score drops on the same side so a goal and its score correction are announced once.
+func (t *tracked) disallowed(diff fotmob.EventDiff, details *api.MatchDetails, change [2]int) []api.MatchEvent {
+	var ruledOut []api.MatchEvent
+	for _, event := range diff.RuledOut {
+		side := 1
+		if event.Team.ID == details.HomeTeam.ID {
+			side = 0
+		}
+		if t.corrections[side] >= 0 {
+			ruledOut = append(ruledOut, event)
+		}
+		t.corrections[side]++
+	}
+
+	for side, delta := range change {
+		for range -delta {
+			if t.corrections[side] <= 0 {
+				ruledOut = append(ruledOut, api.MatchEvent{Kind: api.EventGoal, Team: teamFor(details, side)})
+			}
+			t.corrections[side]--
+		}
+	}
+	return ruledOut
+}
+
+// redCards returns the players sent off since the last poll, including
+// earlier yellow cards upgraded to a second yellow.
+func (t *tracked) redCards(diff fotmob.EventDiff) []api.MatchEvent {
+	var cards []api.MatchEvent
+	for _, event := range append(append([]api.MatchEvent{}, diff.Added...), diff.Modified...) {
+		if event.Kind.IsSendingOff() && !wasSendingOff(t.events, event.ID) {
+			cards = append(cards, event)
+		}
+	}
+	return cards
+}
+
+// teamFor returns the home team for side 0 and the away team for side 1.
+func teamFor(details *api.MatchDetails, side int) api.Team {
+	if side == 0 {
+		return details.HomeTeam
+	}
+	return details.AwayTeam
+}
+
+// wasGoal reports whether the event was already a goal in the previous poll.
+func wasGoal(events []api.MatchEvent, id int) bool {
+	for _, event := range events {
+		if event.ID == id {
+			return event.Kind.IsGoal()
+		}
+	}
+	return false
+}
+
+// penalties returns the penalties given by VAR and the penalties missed among the new events.
+func penalties(diff fotmob.EventDiff) (awarded, missed []api.MatchEvent) {
+	for _, event := range diff.Added {
+		switch {
+		case event.Kind == api.EventMissedPenalty:
+			missed = append(missed, event)
+		case event.Kind == api.EventVAR && penaltyGiven(event.Detail):
+			awarded = append(awarded, event)
+		}
+	}
+	return awarded, missed
+}
+
+// penaltyGiven reports whether a VAR decision gives a penalty (e.g., "Penalty confirmed", "Penalty awarded"),
+// as opposed to taking one away (e.g., "Penalty cancelled", "No penalty").
+func penaltyGiven(decision string) bool {
+	decision = strings.ToLower(decision)
+	if !strings.Contains(decision, "penalty") {
+		return false
+	}
+	for _, overturned := range []string{"no penalty", "cancel", "overturn", "reject"} {
+		if strings.Contains(decision, overturned) {
+			return false
+		}
+	}
+	return true
+}
+
+// wasSendingOff reports whether the event was already a sending-off in the previous poll.
+func wasSendingOff(events []api.MatchEvent, id int) bool {
+	for _, event := range events {
+		if event.ID == id {
+			return event.Kind.IsSendingOff()
+		}
+	}
+	return false
+}
+
+// latestGoal returns the team's most recent goal.
+func latestGoal(events []api.MatchEvent, team api.Team) (api.MatchEvent, bool) {
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Kind.IsGoal() && events[i].Team.ID == team.ID {
+			return events[i], true
+		}
+	}
+	return api.MatchEvent{}, false
+}
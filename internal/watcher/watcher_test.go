@@ -0,0 +1,218 @@
+package watcher
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/notify"
+)
+
+func TestTrackedGoalsAndCorrections(t *testing.T) {
+	home := api.Team{ID: 1, ShortName: "HOM"}
+	away := api.Team{ID: 2, ShortName: "AWY"}
+	name := func(s string) *string { return &s }
+	score := func(n int) *int { return &n }
+
+	details := func(homeScore, awayScore int, events ...api.MatchEvent) *api.MatchDetails {
+		return &api.MatchDetails{
+			Match: api.Match{
+				ID:        10,
+				HomeTeam:  home,
+				AwayTeam:  away,
+				Status:    api.MatchStatusLive,
+				State:     api.MatchState{Phase: api.PhaseSecondHalf, Minute: 60},
+				HomeScore: score(homeScore),
+				AwayScore: score(awayScore),
+			},
+			Events: events,
+		}
+	}
+	opener := api.MatchEvent{ID: 1, Minute: 12, Kind: api.EventGoal, Team: home, Player: name("Opener")}
+	offside := api.MatchEvent{ID: 2, Minute: 58, Kind: api.EventGoal, Team: away, Player: name("Offside")}
+
+	var tr tracked
+	var prev *api.MatchDetails
+	observe := func(d *api.MatchDetails) Update {
+		u := tr.observe(fotmob.NewMatchUpdate(prev, d))
+		prev = d
+		return u
+	}
+
+	// The initial snapshot is the baseline
+	if u := observe(details(1, 0, opener)); len(u.Goals) != 0 || len(u.Disallowed) != 0 {
+		t.Fatalf("baseline update = %+v; want no announcements", u)
+	}
+
+	u := observe(details(1, 1, opener, offside))
+	if len(u.Goals) != 1 || u.Goals[0].ID != 2 {
+		t.Fatalf("Goals = %+v; want the away goal", u.Goals)
+	}
+
+	// Score corrected before the event is removed: announced once, for the team
+	u = observe(details(1, 0, opener, offside))
+	if len(u.Disallowed) != 1 || u.Disallowed[0].Team.ID != away.ID || u.Disallowed[0].Player != nil {
+		t.Fatalf("Disallowed after score drop = %+v; want one team-only correction", u.Disallowed)
+	}
+	if u = observe(details(1, 0, opener)); len(u.Disallowed) != 0 {
+		t.Errorf("Disallowed after event removal = %+v; want none (already corrected)", u.Disallowed)
+	}
+
+	// Event removed together with the score: announced with the scorer
+	observe(details(2, 0, opener, api.MatchEvent{ID: 3, Minute: 70, Kind: api.EventGoal, Team: home, Player: name("Handball")}))
+	u = observe(details(1, 0, opener))
+	if len(u.Disallowed) != 1 || u.Disallowed[0].ID != 3 {
+		t.Errorf("Disallowed = %+v; want goal 3", u.Disallowed)
+	}
+	if len(u.Goals) != 0 {
+		t.Errorf("Goals = %+v; want none", u.Goals)
+	}
+}
+
+func TestUpdateNotifyEvents(t *testing.T) {
+	home := api.Team{ID: 1, ShortName: "HOM"}
+	away := api.Team{ID: 2, ShortName: "AWY"}
+	score := func(n int) *int { return &n }
+
+	details := func(phase api.MatchPhase, minute int, events ...api.MatchEvent) *api.MatchDetails {
+		return &api.MatchDetails{
+			Match: api.Match{
+				ID:        10,
+				HomeTeam:  home,
+				AwayTeam:  away,
+				State:     api.MatchState{Phase: phase, Minute: minute},
+				HomeScore: score(0),
+				AwayScore: score(0),
+			},
+			Events: events,
+		}
+	}
+	withLineups := func(d *api.MatchDetails, predicted bool) *api.MatchDetails {
+		d.HomeStarting = []api.PlayerInfo{{ID: 1, Name: "Keeper"}}
+		d.LineupPredicted = predicted
+		return d
+	}
+	booking := api.MatchEvent{ID: 1, Minute: 30, Kind: api.EventYellowCard, Team: away}
+	secondYellow := booking
+	secondYellow.Kind = api.EventSecondYellow
+	review := api.MatchEvent{ID: 2, Minute: 60, Kind: api.EventVAR, Team: home, Detail: "Penalty confirmed"}
+	miss := api.MatchEvent{ID: 3, Minute: 61, Kind: api.EventMissedPenalty, Team: home}
+
+	var tr tracked
+	var prev *api.MatchDetails
+	kinds := func(d *api.MatchDetails) []notify.Kind {
+		u := tr.observe(fotmob.NewMatchUpdate(prev, d))
+		prev = d
+		var got []notify.Kind
+		for _, event := range u.NotifyEvents() {
+			got = append(got, event.Kind)
+		}
+		return got
+	}
+
+	tests := []struct {
+		desc    string
+		details *api.MatchDetails
+		want    []notify.Kind
+	}{
+		{"predicted lineups", withLineups(details(api.PhasePreMatch, 0), true), nil},
+		{"confirmed lineups", withLineups(details(api.PhasePreMatch, 0), false), []notify.Kind{notify.KindLineups}},
+		{"kickoff", withLineups(details(api.PhaseFirstHalf, 1), false), []notify.Kind{notify.KindKickoff}},
+		{"yellow card", details(api.PhaseFirstHalf, 30, booking), nil},
+		{"upgraded to a second yellow", details(api.PhaseFirstHalf, 31, secondYellow), []notify.Kind{notify.KindRedCard}},
+		{"half-time", details(api.PhaseHalfTime, 45, secondYellow), []notify.Kind{notify.KindHalfTime}},
+		{"penalty given and missed", details(api.PhaseSecondHalf, 61, secondYellow, review, miss), []notify.Kind{notify.KindPenaltyAwarded, notify.KindPenaltyMissed}},
+		{"full-time", details(api.PhaseFullTime, 90, secondYellow, review, miss), []notify.Kind{notify.KindFullTime}},
+	}
+	for _, tt := range tests {
+		if got := kinds(tt.details); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: NotifyEvents() kinds = %v; want %v", tt.desc, got, tt.want)
+		}
+	}
+
+	// A match found in play announces its kickoff only if just started, and never its lineups
+	tr, prev = tracked{}, nil
+	if got := kinds(withLineups(details(api.PhaseFirstHalf, 2), false)); !slices.Equal(got, []notify.Kind{notify.KindKickoff}) {
+		t.Errorf("found at 2': NotifyEvents() kinds = %v; want [kickoff]", got)
+	}
+	tr, prev = tracked{}, nil
+	if got := kinds(details(api.PhaseFirstHalf, 20)); got != nil {
+		t.Errorf("found at 20': NotifyEvents() kinds = %v; want none", got)
+	}
+
+	// Postponed before kickoff
+	tr, prev = tracked{}, nil
+	kinds(details(api.PhasePreMatch, 0))
+	if got := kinds(details(api.PhasePostponed, 0)); !slices.Equal(got, []notify.Kind{notify.KindPostponed}) {
+		t.Errorf("postponed: NotifyEvents() kinds = %v; want [postponed]", got)
+	}
+}
+
+// streamSource serves one test-fed stream per match and no match list.
+type streamSource struct {
+	streams map[int]chan fotmob.MatchUpdate
+}
+
+func (s streamSource) TodayMatches(ctx context.Context) ([]api.Match, error) {
+	return nil, nil
+}
+
+func (s streamSource) Watch(ctx context.Context, matchID int) <-chan fotmob.MatchUpdate {
+	return s.streams[matchID]
+}
+
+func TestSubscribe(t *testing.T) {
+	stream := make(chan fotmob.MatchUpdate)
+	w := New(streamSource{streams: map[int]chan fotmob.MatchUpdate{10: stream}})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w.Start(ctx)
+
+	receive := func(desc string, updates <-chan fotmob.MatchUpdate) (fotmob.MatchUpdate, bool) {
+		select {
+		case update, ok := <-updates:
+			return update, ok
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s: no update", desc)
+			return fotmob.MatchUpdate{}, false
+		}
+	}
+	details := func(minute int) *api.MatchDetails {
+		return &api.MatchDetails{Match: api.Match{ID: 10, State: api.MatchState{Phase: api.PhaseFirstHalf, Minute: minute}}}
+	}
+
+	// Subscribing follows the match, and the subscriber gets its polls
+	first, _ := w.Subscribe(10)
+	stream <- fotmob.NewMatchUpdate(nil, details(10))
+	if u, _ := receive("first poll", first); !u.Initial || u.Details.State.Minute != 10 {
+		t.Fatalf("first poll = %+v; want the initial snapshot at 10'", u)
+	}
+	<-w.Updates()
+
+	// A later subscriber starts from the latest poll, then shares the stream
+	second, unsubscribe := w.Subscribe(10)
+	if u, _ := receive("late subscriber", second); !u.Initial || u.Details.State.Minute != 10 {
+		t.Fatalf("late subscriber = %+v; want a snapshot of the latest poll", u)
+	}
+	stream <- fotmob.NewMatchUpdate(details(10), details(11))
+	for _, updates := range []<-chan fotmob.MatchUpdate{first, second} {
+		if u, _ := receive("second poll", updates); u.Initial || u.Details.State.Minute != 11 {
+			t.Errorf("second poll = %+v; want the poll at 11'", u)
+		}
+	}
+	<-w.Updates()
+
+	unsubscribe()
+	if _, ok := receive("unsubscribed", second); ok {
+		t.Errorf("unsubscribed channel still open")
+	}
+
+	// Subscribers are closed when the watch ends
+	close(stream)
+	if _, ok := receive("watch ended", first); ok {
+		t.Errorf("channel still open after the watch ended")
+	}
+}
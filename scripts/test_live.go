@@ -107,8 +107,8 @@ func main() {
 			}
 
 			liveTime := ""
-			if match.LiveTime != nil {
-				liveTime = fmt.Sprintf(" (%s)", *match.LiveTime)
+			if label := match.State.Label(); label != "" {
+				liveTime = fmt.Sprintf(" (%s)", label)
 			}
 
 			fmt.Printf("  %d. %s %s-%s %s [%s]%s\n",